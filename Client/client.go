@@ -2,27 +2,42 @@ package main
 
 import (
 	"../Protobuf"
+	"../Ring"
+	"../Transport"
 	"bufio"
+	"context"
+	"flag"
 	"fmt"
-	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"io"
 	"log"
+	"math"
 	"net"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 //--------------------------------------------------------//
 
 //Constants Declaration
 const Client = "CLIENT"
-const maxBytes = 8192
+const requestTimeout = 10 * time.Second
+const repairTimeout = 10 * time.Minute
+const grpcPortOffset = 1 //Default gRPC Port = Replica Port + 1
+const defaultKeyspace = "default"
+const defaultReadRepairChance = 1.0
 
 type replica struct {
 	Name       string
 	IP         string
 	Port       string
+	GrpcPort   string
 	TCPAddress *net.TCPAddr
+	Stub       cassandra.ReplicaClient
 }
 
 var loadAllReplicas = []*cassandra.InitReplicaCluster_Replica{}
@@ -33,12 +48,25 @@ var replicaConn = []*replica{}
 var totalReplicas uint32 = 0
 var replicaIndex = 0
 
+//Keyspace Used by PUT, GET and SCAN Requests
+var currentKeyspace = defaultKeyspace
+
 //--------------------------------------------------------//
 
 func main() {
 
+	//Optional Settings - Must Precede the Config File Name
+	maxMessageSize := flag.Uint("max-message-size", Transport.DefaultMaxMessageSize, "Largest request/response frame in bytes")
+	flag.Parse()
+
+	//Frame Lengths are 32 Bits - a Larger Limit Would Wrap Around to a Small One
+	if *maxMessageSize > math.MaxUint32 {
+		log.Fatal("Max Message Size ", *maxMessageSize, " is Above the Largest Frame (", uint64(math.MaxUint32), " Bytes)")
+	}
+	Transport.SetMaxMessageSize(uint32(*maxMessageSize))
+
 	//Receive Replica Config Name
-	replicaFileName := os.Args[1]
+	replicaFileName := flag.Arg(0)
 
 	//Identify the Replicas in the Cluster
 	ReplicaClusterSetup(replicaFileName)
@@ -79,9 +107,24 @@ func Process() {
 			ProcessReadRequest()
 
 		case "5":
-			ResetReplicaStorage()
+			ProcessScanRequest()
 
 		case "6":
+			ProcessCreateKeyspaceRequest()
+
+		case "7":
+			ProcessUseKeyspace()
+
+		case "8":
+			ProcessRepairRequest()
+
+		case "9":
+			ProcessDecommissionRequest()
+
+		case "10":
+			ResetReplicaStorage()
+
+		case "11":
 			return
 
 		default:
@@ -103,31 +146,20 @@ func Process() {
 func InitReplicas() {
 
 	//
-	initReplicaMsg := new(cassandra.InputRequest_InitReplica)
-	initReplicaMsg.InitReplica = new(cassandra.InitReplicaCluster)
-	initReplicaMsg.InitReplica.AllReplica = loadAllReplicas
-
-	//Complete String message for InitReplica
-	replicaMsg := new(cassandra.InputRequest)
-	replicaMsg.InputRequest = initReplicaMsg
-
-	protoInitReplicaMsg, err := proto.Marshal(replicaMsg)
-
-	if err != nil {
-		log.Fatal("Marshalling Error @ InitReplica: ", err)
-	}
+	initReplicaMsg := new(cassandra.InitReplicaCluster)
+	initReplicaMsg.AllReplica = loadAllReplicas
 
 	//Send InitReplica Message to All the Replicas
 	for _, thisReplica := range replicaConn {
 
-		thisReplicaChannel, err := net.DialTCP("tcp", nil, thisReplica.TCPAddress)
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		_, err := thisReplica.Stub.InitCluster(ctx, initReplicaMsg)
+		cancel()
 
 		if err != nil {
-			fmt.Println("Connection Error. ", thisReplica.Name, err)
+			fmt.Println("Initialize Error. ", thisReplica.Name, status.Convert(err).Message())
 		} else {
-			thisReplicaChannel.Write(protoInitReplicaMsg)
 			fmt.Println(thisReplica.Name, "Initialized.!")
-
 		}
 
 	}
@@ -158,7 +190,7 @@ func DecideCoordinator() {
 		replicaSlt := scanner.Text()
 		val, err := strconv.Atoi(replicaSlt)
 
-		if val < 1 || val > 4 || err != nil {
+		if val < 1 || val > len(replicaConn) || err != nil {
 
 			fmt.Println("Error: Invalid Selection.")
 
@@ -190,10 +222,12 @@ func ProcessPutRequest() {
 	value := " "
 	consistency := " "
 
+	//Allow Values up to the Maximum Message Size
 	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Buffer(make([]byte, bufio.MaxScanTokenSize), int(Transport.MaxMessageSize))
 
 	//KEY
-	fmt.Print("Enter Key (0~255): ")
+	fmt.Print("Enter Key: ")
 	for scanner.Scan() {
 
 		if scanner.Text() == "RETURN" {
@@ -201,11 +235,10 @@ func ProcessPutRequest() {
 		}
 
 		keyString = scanner.Text()
-		val, err := strconv.Atoi(keyString)
 
-		if val < 0 || val > 255 || err != nil {
+		if keyString == "" {
 			fmt.Println("Error: Not a valid KEY.")
-			fmt.Print("Enter Key (0~255): ")
+			fmt.Print("Enter Key: ")
 		} else {
 			break
 		}
@@ -231,7 +264,7 @@ func ProcessPutRequest() {
 	}
 
 	//CONSISTENCY
-	fmt.Print("Enter Consistency Level (ONE/QUORUM) : ")
+	fmt.Print("Enter Consistency Level (ONE/TWO/THREE/QUORUM/LOCAL_QUORUM/EACH_QUORUM/ALL/ANY) : ")
 	for scanner.Scan() {
 		consistency = scanner.Text()
 
@@ -239,83 +272,49 @@ func ProcessPutRequest() {
 			return
 		}
 
-		if !(consistency == "ONE" || consistency == "QUORUM") {
+		if _, valid := cassandra.RequestParameter_Consistency_value[consistency]; !valid {
 			fmt.Println("Error: Not a valid CONSISTENCY.")
-			fmt.Print("Enter Consistency Level (ONE/QUORUM) : ")
+			fmt.Print("Enter Consistency Level (ONE/TWO/THREE/QUORUM/LOCAL_QUORUM/EACH_QUORUM/ALL/ANY) : ")
 		} else {
 			break
 		}
 
 	}
 
-	keyVal, _ := strconv.Atoi(keyString)
-	PutRequest(uint32(keyVal), value, consistency)
+	PutRequest([]byte(keyString), value, consistency)
 
 }
 
 //--------------------------------------------------------//
 
-func PutRequest(keyValue uint32, value string, consistency string) {
+func PutRequest(keyValue []byte, value string, consistency string) {
 
 	//Built PUT Message Request
-	putMessage := new(cassandra.InputRequest_ClientPut)
-	putMessage.ClientPut = new(cassandra.ClientPut)
-	putMessage.ClientPut.Input = new(cassandra.RequestParameter)
-	putMessage.ClientPut.Input.Key = keyValue
-
-	if consistency == "ONE" {
-		putMessage.ClientPut.Input.Consistency = cassandra.RequestParameter_ONE
-	} else if consistency == "QUORUM" {
-		putMessage.ClientPut.Input.Consistency = cassandra.RequestParameter_QUORUM
-
-	}
+	putMessage := new(cassandra.ClientPut)
+	putMessage.Input = new(cassandra.RequestParameter)
+	putMessage.Input.Key = keyValue
+	putMessage.Input.Keyspace = currentKeyspace
 
-	putMessage.ClientPut.Input.OriginReplica = Client
-	putMessage.ClientPut.Input.Value = value
+	putMessage.Input.Consistency = cassandra.RequestParameter_Consistency(cassandra.RequestParameter_Consistency_value[consistency])
 
-	//Make Input Request
-	replicaMsg := new(cassandra.InputRequest)
-	replicaMsg.InputRequest = putMessage
+	putMessage.Input.OriginReplica = Client
+	putMessage.Input.Value = value
 
-	//Protobuf Message
-	protoPutMsg, err := proto.Marshal(replicaMsg)
-
-	if err != nil {
-		fmt.Println("Marshalling Error @ PUT Request: ", err)
-		return
-	}
+	//Send Request with a Deadline
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
 
-	//Make Connection
-	channel, err := net.DialTCP("tcp", nil, replicaConn[replicaIndex].TCPAddress)
+	replicaResponse, err := replicaConn[replicaIndex].Stub.Put(ctx, putMessage)
 
+	//Display Response
+	fmt.Println("===> PUT Request Response")
+	fmt.Println("Keyspace =", currentKeyspace, "; Key =", string(keyValue), "; Value =", value, "; Consistency =", consistency, "; Coordinator =", replicaConn[replicaIndex].Name)
 	if err != nil {
-		fmt.Println("Connection Error. ", err)
-		return
+		fmt.Println("Status: false ; Code:", status.Code(err), "; Message:", status.Convert(err).Message())
 	} else {
-		channel.Write(protoPutMsg) //Send Request
-
-		//ReadResponse
-		respBuff := make([]byte, maxBytes)
-		_, err := channel.Read(respBuff)
-
-		if err != nil {
-			fmt.Println("Error while Reading response. ", err)
-			return
-		} else {
-
-			//Display Response
-			respMsg := new(cassandra.InputRequest)
-			proto.Unmarshal(respBuff, respMsg)
-
-			replicaResponse := respMsg.GetResponse()
-
-			fmt.Println("===> PUT Request Response")
-			fmt.Println("Key =", keyValue, "; Value =", value, "; Consistency =", consistency, "; Coordinator =", replicaConn[replicaIndex].Name)
-			fmt.Println("Status:", replicaResponse.GetStatus(), "; Message:", replicaResponse.GetRespMessage())
-			fmt.Println("--------------------------------------------")
-		}
-
+		fmt.Println("Status:", replicaResponse.GetStatus(), "; Message:", replicaResponse.GetRespMessage())
 	}
+	fmt.Println("--------------------------------------------")
 
 }
 
@@ -331,7 +330,7 @@ func ProcessReadRequest() {
 	keyString := " "
 	consistency := " "
 
-	fmt.Print("Enter Key (0~255): ")
+	fmt.Print("Enter Key: ")
 	for scanner.Scan() {
 
 		if scanner.Text() == "RETURN" {
@@ -339,19 +338,18 @@ func ProcessReadRequest() {
 		}
 
 		keyString = scanner.Text()
-		val, err := strconv.Atoi(keyString)
 
-		if val < 0 || val > 255 || err != nil {
+		if keyString == "" {
 			fmt.Println("Error: Not a valid KEY.")
-			fmt.Print("Enter Key (0~255): ")
+			fmt.Print("Enter Key: ")
 		} else {
 			break
 		}
 
 	}
 
-	//CONSISTENCY
-	fmt.Print("Enter Consistency Level (ONE/QUORUM) : ")
+	//CONSISTENCY - ANY Applies to Writes Only
+	fmt.Print("Enter Consistency Level (ONE/TWO/THREE/QUORUM/LOCAL_QUORUM/EACH_QUORUM/ALL) : ")
 	for scanner.Scan() {
 		consistency = scanner.Text()
 
@@ -359,9 +357,9 @@ func ProcessReadRequest() {
 			return
 		}
 
-		if !(consistency == "ONE" || consistency == "QUORUM") {
+		if _, valid := cassandra.ClientRead_Consistency_value[consistency]; !valid {
 			fmt.Println("Error: Not a valid CONSISTENCY.")
-			fmt.Print("Enter Consistency Level (ONE/QUORUM) : ")
+			fmt.Print("Enter Consistency Level (ONE/TWO/THREE/QUORUM/LOCAL_QUORUM/EACH_QUORUM/ALL) : ")
 		} else {
 			break
 		}
@@ -369,73 +367,314 @@ func ProcessReadRequest() {
 	}
 
 	//
-	key, _ := strconv.Atoi(keyString)
-
 	//Initiate Read Request
-	ReadRequest(uint32(key), consistency)
+	ReadRequest([]byte(keyString), consistency)
 
 }
 
 //--------------------------------------------------------//
 
-func ReadRequest(key uint32, consistency string) {
+func ReadRequest(key []byte, consistency string) {
 
-	readMessage := new(cassandra.InputRequest_ClientRead)
-	readMessage.ClientRead = new(cassandra.ClientRead)
-	readMessage.ClientRead.Key = key
+	readMessage := new(cassandra.ClientRead)
+	readMessage.Key = key
+	readMessage.Keyspace = currentKeyspace
 
-	if consistency == "ONE" {
-		readMessage.ClientRead.Consistency = cassandra.ClientRead_ONE
-	} else if consistency == "QUORUM" {
-		readMessage.ClientRead.Consistency = cassandra.ClientRead_QUORUM
-	}
+	readMessage.Consistency = cassandra.ClientRead_Consistency(cassandra.ClientRead_Consistency_value[consistency])
 
-	//Make Input Request
-	clientReadMsg := new(cassandra.InputRequest)
-	clientReadMsg.InputRequest = readMessage
+	//Send Request with a Deadline
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
 
-	//Protobuf Message
-	protoReadMsg, err := proto.Marshal(clientReadMsg)
+	replicaResponse, err := replicaConn[replicaIndex].Stub.Get(ctx, readMessage)
 
+	//Display Response
+	fmt.Println("GET Request: Keyspace =", currentKeyspace, "; Key =", string(key))
 	if err != nil {
-		fmt.Println("Marshalling Error @ PUT Request: ", err)
+		fmt.Println("Replica Coordinator:", replicaConn[replicaIndex].Name)
+		fmt.Println("Request Status: false ; Code:", status.Code(err))
+		fmt.Println("Response Msg:", status.Convert(err).Message())
+	} else {
+		fmt.Println("Value:", replicaResponse.GetValue())
+		fmt.Println("Replica Coordinator:", replicaConn[replicaIndex].Name)
+		fmt.Println("Request Status:", replicaResponse.GetStatus())
+		fmt.Println("Response Msg:", replicaResponse.GetRespMessage())
+	}
+	fmt.Println("--------------------------------------------")
+
+}
+
+//--------------------------------------------------------//
+
+func ProcessScanRequest() {
+
+	fmt.Println("------------- SCAN Request -------------------")
+
+	scanner := bufio.NewScanner(os.Stdin)
+
+	keyRange := [][]byte{}
+
+	//An Empty Key Leaves that End of the Range Open
+	for _, prompt := range []string{"Enter Start Key (Empty for First Key): ", "Enter End Key (Empty for Last Key): "} {
+
+		fmt.Print(prompt)
+		if !scanner.Scan() || scanner.Text() == "RETURN" {
+			return
+		}
+
+		keyRange = append(keyRange, []byte(scanner.Text()))
+
+	}
+
+	if len(keyRange) != 2 {
 		return
 	}
 
-	//Make Connection
-	channel, err := net.DialTCP("tcp", nil, replicaConn[replicaIndex].TCPAddress)
+	ScanRequest(keyRange[0], keyRange[1])
+
+}
+
+//--------------------------------------------------------//
+
+func ScanRequest(startKey []byte, endKey []byte) {
+
+	scanMessage := new(cassandra.ScanRequest)
+	scanMessage.StartKey = startKey
+	scanMessage.EndKey = endKey
+	scanMessage.Keyspace = currentKeyspace
 
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	stream, err := replicaConn[replicaIndex].Stub.Scan(ctx, scanMessage)
 	if err != nil {
-		fmt.Println("Connection Error. ", err)
-	} else {
+		fmt.Println("SCAN Error. ", status.Convert(err).Message())
+		return
+	}
 
-		//Send Request
-		channel.Write(protoReadMsg)
+	fmt.Println("SCAN Request: Keyspace", currentKeyspace, "; Keys", string(startKey), "~", string(endKey), "; Replica =", replicaConn[replicaIndex].Name)
 
-		//ReadResponse
-		respBuff := make([]byte, maxBytes)
-		_, err := channel.Read(respBuff)
+	//Receive Each Key-Value Pair as it is Streamed
+	pairCount := 0
+	for {
+
+		scanResponse, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
 
 		if err != nil {
-			fmt.Println("Error while Reading response. ", err)
+			fmt.Println("SCAN Error. ", status.Convert(err).Message())
+			break
+		}
+
+		fmt.Println("Key =", string(scanResponse.GetKey()), "; Value =", scanResponse.GetValue(), "; Time =", scanResponse.GetArrival())
+		pairCount++
+
+	}
+
+	fmt.Println("Key-Value Pairs Found:", pairCount)
+	fmt.Println("--------------------------------------------")
+
+}
+
+//--------------------------------------------------------//
+
+func ProcessCreateKeyspaceRequest() {
+
+	fmt.Println("------------- Create Keyspace ----------------")
+
+	scanner := bufio.NewScanner(os.Stdin)
+
+	keyspace := ""
+	replicationFactor := 0
+	datacenters := map[string]int{}
+	readRepairChance := -1.0
+	hintedHandOff := ""
+
+	//KEYSPACE NAME
+	fmt.Print("Enter Keyspace Name: ")
+	for scanner.Scan() {
+
+		if scanner.Text() == "RETURN" {
 			return
+		}
+
+		keyspace = scanner.Text()
+
+		if keyspace == "" {
+			fmt.Println("Error: Not a valid KEYSPACE.")
+			fmt.Print("Enter Keyspace Name: ")
 		} else {
+			break
+		}
+
+	}
+
+	//REPLICATION FACTOR - A Number (SimpleStrategy) or Replicas per Datacenter (NetworkTopologyStrategy)
+	fmt.Print("Enter Replication Factor (e.g. 3, or dc1:3,dc2:2 per Datacenter): ")
+	for scanner.Scan() {
+
+		if scanner.Text() == "RETURN" {
+			return
+		}
 
-			//Display Response
-			respMsg := new(cassandra.InputRequest)
-			proto.Unmarshal(respBuff, respMsg)
+		if strings.Contains(scanner.Text(), ":") {
 
-			replicaResponse := respMsg.GetResponse()
+			val, err := Ring.ParseDatacenters(scanner.Text())
 
-			//fmt.Println("--------------------------------------------")
-			fmt.Println("GET Request: Key =", replicaResponse.GetKey())
-			if replicaResponse.GetStatus() {
-				fmt.Println("Value:", replicaResponse.GetValue())
+			if err != nil {
+				fmt.Println("Error: Not a valid REPLICATION FACTOR -", err)
+				fmt.Print("Enter Replication Factor (e.g. 3, or dc1:3,dc2:2 per Datacenter): ")
+			} else {
+				datacenters = val
+				replicationFactor = Ring.Strategy{Datacenters: val}.Replicas()
+				break
 			}
-			fmt.Println("Replica Coordinator:", replicaConn[replicaIndex].Name)
-			fmt.Println("Request Status:", replicaResponse.GetStatus())
-			fmt.Println("Response Msg:", replicaResponse.GetRespMessage())
+
+			continue
+		}
+
+		val, err := strconv.Atoi(scanner.Text())
+
+		if val < 1 || err != nil {
+			fmt.Println("Error: Not a valid REPLICATION FACTOR.")
+			fmt.Print("Enter Replication Factor (e.g. 3, or dc1:3,dc2:2 per Datacenter): ")
+		} else {
+			replicationFactor = val
+			break
+		}
+
+	}
+
+	if replicationFactor == 0 {
+		return
+	}
+
+	//READ REPAIR CHANCE - Empty Input Takes the Default
+	fmt.Print("Enter Read Repair Chance (0-1) [1]: ")
+	for scanner.Scan() {
+
+		if scanner.Text() == "RETURN" {
+			return
+		}
+
+		if scanner.Text() == "" {
+			readRepairChance = defaultReadRepairChance
+			break
+		}
+
+		val, err := strconv.ParseFloat(scanner.Text(), 64)
+
+		if val < 0 || val > 1 || err != nil {
+			fmt.Println("Error: Not a valid READ REPAIR CHANCE.")
+			fmt.Print("Enter Read Repair Chance (0-1) [1]: ")
+		} else {
+			readRepairChance = val
+			break
+		}
+
+	}
+
+	if readRepairChance < 0 {
+		return
+	}
+
+	//HINTED HAND-OFF - Empty Input Takes the Default
+	fmt.Print("Enable Hinted Hand-Off (Y/N) [Y]: ")
+	for scanner.Scan() {
+
+		if scanner.Text() == "RETURN" {
+			return
+		}
+
+		hintedHandOff = strings.ToUpper(scanner.Text())
+
+		if hintedHandOff == "" || hintedHandOff == "Y" || hintedHandOff == "N" {
+			break
+		}
+
+		fmt.Println("Error: Enter Y or N.")
+		fmt.Print("Enable Hinted Hand-Off (Y/N) [Y]: ")
+
+	}
+
+	keyspaceMessage := new(cassandra.KeyspaceDefinition)
+	keyspaceMessage.Name = keyspace
+	keyspaceMessage.ReplicationFactor = uint32(replicationFactor)
+	for datacenter, datacenterReplicas := range datacenters {
+		if keyspaceMessage.DatacenterReplication == nil {
+			keyspaceMessage.DatacenterReplication = make(map[string]uint32)
+		}
+		keyspaceMessage.DatacenterReplication[datacenter] = uint32(datacenterReplicas)
+	}
+	keyspaceMessage.ReadRepairChance = readRepairChance
+	keyspaceMessage.HintedHandoff = hintedHandOff != "N"
+
+	CreateKeyspaceRequest(keyspaceMessage)
+
+}
+
+//--------------------------------------------------------//
+
+func CreateKeyspaceRequest(keyspaceMessage *cassandra.KeyspaceDefinition) {
+
+	keyspace := keyspaceMessage.GetName()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	replicaResponse, err := replicaConn[replicaIndex].Stub.CreateKeyspace(ctx, keyspaceMessage)
+
+	//Display Response
+	fmt.Println("===> Create Keyspace Response")
+	replication := strconv.Itoa(int(keyspaceMessage.GetReplicationFactor()))
+	if len(keyspaceMessage.GetDatacenterReplication()) > 0 {
+		datacenters := make(map[string]int)
+		for datacenter, datacenterReplicas := range keyspaceMessage.GetDatacenterReplication() {
+			datacenters[datacenter] = int(datacenterReplicas)
+		}
+		replication = Ring.FormatDatacenters(datacenters)
+	}
+
+	fmt.Println("Keyspace =", keyspace, "; Replication Factor =", replication, "; Read Repair Chance =",
+		keyspaceMessage.GetReadRepairChance(), "; Hinted Hand-Off =", keyspaceMessage.GetHintedHandoff(), "; Coordinator =", replicaConn[replicaIndex].Name)
+	if err != nil {
+		fmt.Println("Status: false ; Code:", status.Code(err), "; Message:", status.Convert(err).Message())
+	} else {
+		fmt.Println("Status:", replicaResponse.GetStatus(), "; Message:", replicaResponse.GetRespMessage())
+
+		//Later Requests Go to the New Keyspace
+		currentKeyspace = keyspace
+		fmt.Println("Current Keyspace:", currentKeyspace)
+	}
+	fmt.Println("--------------------------------------------")
+
+}
+
+//--------------------------------------------------------//
+
+func ProcessUseKeyspace() {
+
+	fmt.Println("Current Keyspace:", currentKeyspace)
+	fmt.Print("Enter Keyspace Name: ")
+
+	scanner := bufio.NewScanner(os.Stdin)
+
+	for scanner.Scan() {
+
+		if scanner.Text() == "RETURN" {
+			return
+		}
+
+		if scanner.Text() == "" {
+			fmt.Println("Error: Not a valid KEYSPACE.")
+			fmt.Print("Enter Keyspace Name: ")
+		} else {
+			currentKeyspace = scanner.Text()
+			fmt.Println("Current Keyspace:", currentKeyspace)
 			fmt.Println("--------------------------------------------")
+			return
 		}
 
 	}
@@ -444,13 +683,110 @@ func ReadRequest(key uint32, consistency string) {
 
 //--------------------------------------------------------//
 
+func ProcessRepairRequest() {
+
+	fmt.Println("--------------- Repair Replica ---------------")
+	fmt.Print("Enter Keyspace Name (Empty for All Keyspaces): ")
+
+	scanner := bufio.NewScanner(os.Stdin)
+	if !scanner.Scan() || scanner.Text() == "RETURN" {
+		return
+	}
+
+	RepairRequest(scanner.Text())
+
+}
+
+//--------------------------------------------------------//
+
+//Anti-Entropy Repair of the Coordinator's Ranges Against the Other Replicas; Gets a
+//Longer Deadline, as it Compares Every Range the Coordinator Holds
+func RepairRequest(keyspace string) {
+
+	repairMessage := new(cassandra.RepairRequest)
+	repairMessage.Keyspace = keyspace
+
+	ctx, cancel := context.WithTimeout(context.Background(), repairTimeout)
+	defer cancel()
+
+	replicaResponse, err := replicaConn[replicaIndex].Stub.Repair(ctx, repairMessage)
+
+	if keyspace == "" {
+		keyspace = "(All)"
+	}
+
+	//Display Response
+	fmt.Println("===> Repair Response")
+	fmt.Println("Keyspace =", keyspace, "; Coordinator =", replicaConn[replicaIndex].Name)
+	if err != nil {
+		fmt.Println("Status: false ; Code:", status.Code(err), "; Message:", status.Convert(err).Message())
+	} else {
+		fmt.Println("Status:", replicaResponse.GetStatus(), "; Message:", replicaResponse.GetRespMessage())
+	}
+	fmt.Println("--------------------------------------------")
+
+}
+
+//--------------------------------------------------------//
+
+func ProcessDecommissionRequest() {
+
+	fmt.Println("------------ Decommission Replica ------------")
+	fmt.Print("Decommission ", replicaConn[replicaIndex].Name, " - It Will Leave the Cluster (Y/N) [N]: ")
+
+	scanner := bufio.NewScanner(os.Stdin)
+	if !scanner.Scan() || strings.ToUpper(strings.TrimSpace(scanner.Text())) != "Y" {
+		return
+	}
+
+	DecommissionRequest()
+
+}
+
+//--------------------------------------------------------//
+
+//The Coordinator Streams its Ranges to their Next Owners and Exits; Gets the Repair
+//Deadline, as it Sends Every Row it Holds
+func DecommissionRequest() {
+
+	ctx, cancel := context.WithTimeout(context.Background(), repairTimeout)
+	defer cancel()
+
+	replicaResponse, err := replicaConn[replicaIndex].Stub.Decommission(ctx, new(cassandra.DecommissionRequest))
+
+	//Display Response
+	fmt.Println("===> Decommission Response")
+	fmt.Println("Replica =", replicaConn[replicaIndex].Name)
+	if err != nil {
+		fmt.Println("Status: false ; Code:", status.Code(err), "; Message:", status.Convert(err).Message())
+	} else {
+		fmt.Println("Status:", replicaResponse.GetStatus(), "; Message:", replicaResponse.GetRespMessage())
+	}
+	fmt.Println("--------------------------------------------")
+
+}
+
+//--------------------------------------------------------//
+
 func ResetReplicaStorage() {
 
 	for _, thisReplica := range replicaConn {
 
-		fileName := "../Replicas/" + thisReplica.Name + "Storage.txt"
+		//Memory Engine Storage Log, and the Text Storage File of Older Versions
+		failed := false
+		for _, suffix := range []string{"Storage.log", "Storage.txt"} {
+			err := os.Truncate("../Replicas/"+thisReplica.Name+suffix, 0)
+			if err != nil && !os.IsNotExist(err) {
+				fmt.Println("Error: ", err)
+				failed = true
+			}
+		}
+		if failed {
+			continue
+		}
 
-		err := os.Truncate(fileName, 0)
+		//LSM Engine Data - Commit Log and SSTables
+		err := os.RemoveAll("../Replicas/" + thisReplica.Name + "Data")
 		if err != nil {
 			fmt.Println("Error: ", err)
 		} else {
@@ -490,6 +826,17 @@ func ReplicaClusterSetup(replicaFileName string) {
 		newReplica.Name = replicaDtl[0]
 		newReplica.IP = replicaDtl[1]
 		newReplica.Port = replicaDtl[2]
+		newReplica.GrpcPort = DefaultGrpcPort(newReplica.Port)
+
+		//Optional Columns, in Any Order: the gRPC Port and the Snitch's <Datacenter>:<Rack>
+		datacenter, rack := "", ""
+		for _, column := range replicaDtl[3:] {
+			if columnDC, columnRack, isLocation := strings.Cut(column, ":"); isLocation {
+				datacenter, rack = columnDC, columnRack
+			} else if column != "" {
+				newReplica.GrpcPort = column
+			}
+		}
 		newReplica.TCPAddress, err1 = net.ResolveTCPAddr("tcp", newReplica.IP+":"+newReplica.Port)
 
 		if err1 != nil {
@@ -497,11 +844,25 @@ func ReplicaClusterSetup(replicaFileName string) {
 			log.Fatal(err1)
 		}
 
+		//gRPC Stub; the Connection is Opened Lazily on the First Call
+		grpcConn, err1 := grpc.NewClient(newReplica.IP+":"+newReplica.GrpcPort,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(int(Transport.MaxMessageSize)), grpc.MaxCallSendMsgSize(int(Transport.MaxMessageSize))))
+
+		if err1 != nil {
+			fmt.Println("gRPC Client for", replicaDtl[0], " FAILED.")
+			log.Fatal(err1)
+		}
+		newReplica.Stub = cassandra.NewReplicaClient(grpcConn)
+
 		//Load the Branch Details in the InitBranch Structure
 		newReplica1 := new(cassandra.InitReplicaCluster_Replica)
 		newReplica1.Name = replicaDtl[0]
 		newReplica1.Ip = replicaDtl[1]
 		newReplica1.Port = replicaDtl[2]
+		newReplica1.GrpcPort = newReplica.GrpcPort
+		newReplica1.Datacenter = datacenter
+		newReplica1.Rack = rack
 
 		loadAllReplicas = append(loadAllReplicas, newReplica1)
 
@@ -524,10 +885,27 @@ func DisplayMenu() {
 	fmt.Println("2. Select Replica Coordinator")
 	fmt.Println("3. PUT Request")
 	fmt.Println("4. GET Request")
-	fmt.Println("5. Erase Replica Persistent Storage")
-	fmt.Println("6. Exit")
+	fmt.Println("5. SCAN Request")
+	fmt.Println("6. Create Keyspace")
+	fmt.Println("7. Use Keyspace")
+	fmt.Println("8. Repair Replica")
+	fmt.Println("9. Decommission Replica")
+	fmt.Println("10. Erase Replica Persistent Storage")
+	fmt.Println("11. Exit")
 	fmt.Print("Enter Your Option: ")
 
 }
 
 //--------------------------------------------------------//
+
+func DefaultGrpcPort(port string) string {
+
+	portNum, err := strconv.Atoi(port)
+	if err != nil {
+		return ""
+	}
+
+	return strconv.Itoa(portNum + grpcPortOffset)
+}
+
+//--------------------------------------------------------//
@@ -0,0 +1,173 @@
+package FailureDetector
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const DefaultThreshold = 8.0
+const windowSize = 1000 //Heartbeat Intervals Kept per Replica
+
+//Phi for Exponentially Distributed Intervals: -log10(e^(-t/mean)) = t / (mean * ln 10)
+var phiFactor = 1 / math.Ln10
+
+//Phi Accrual Failure Detector: Instead of a Yes/No Timeout, Each Replica Gets a
+//Suspicion Level (Phi) that Grows the Longer its Next Heartbeat is Overdue,
+//Measured Against the Intervals Seen So Far. Phi >= Threshold Means DOWN
+type Detector struct {
+	Threshold       float64
+	InitialInterval time.Duration //Assumed Interval Before any are Measured
+
+	mtx     sync.Mutex
+	windows map[string]*arrivalWindow
+	down    map[string]bool
+}
+
+//Recent Heartbeat Intervals of One Replica
+type arrivalWindow struct {
+	last      time.Time
+	intervals []float64 //Seconds, Oldest First
+	sum       float64
+}
+
+//---------------------------------------------------------------------------//
+
+func New(threshold float64, initialInterval time.Duration) *Detector {
+
+	if threshold <= 0 {
+		threshold = DefaultThreshold
+	}
+
+	detector := new(Detector)
+	detector.Threshold = threshold
+	detector.InitialInterval = initialInterval
+	detector.windows = make(map[string]*arrivalWindow)
+	detector.down = make(map[string]bool)
+
+	return detector
+}
+
+//---------------------------------------------------------------------------//
+
+//A Heartbeat from the Replica Arrived; the First One Starts its Window
+func (d *Detector) Report(name string) {
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	now := time.Now()
+
+	window, found := d.windows[name]
+	if !found {
+		window = new(arrivalWindow)
+		window.add(d.InitialInterval.Seconds())
+		d.windows[name] = window
+	} else {
+		window.add(now.Sub(window.last).Seconds())
+	}
+	window.last = now
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Suspicion Level of the Replica; Unknown Replicas are Infinitely Suspect
+func (d *Detector) Phi(name string) float64 {
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	return d.phi(name, time.Now())
+}
+
+//---------------------------------------------------------------------------//
+
+func (d *Detector) Alive(name string) bool {
+	return d.Phi(name) < d.Threshold
+}
+
+//---------------------------------------------------------------------------//
+
+//Re-Evaluate Every Replica; Returns Those that Went Down and Came Back Up Since the Last Check
+func (d *Detector) Check() ([]string, []string) {
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	now := time.Now()
+	wentDown := []string{}
+	cameUp := []string{}
+
+	for name := range d.windows {
+
+		isDown := d.phi(name, now) >= d.Threshold
+		if isDown == d.down[name] {
+			continue
+		}
+
+		d.down[name] = isDown
+		if isDown {
+			wentDown = append(wentDown, name)
+		} else {
+			cameUp = append(cameUp, name)
+		}
+
+	}
+
+	sort.Strings(wentDown)
+	sort.Strings(cameUp)
+
+	return wentDown, cameUp
+}
+
+//---------------------------------------------------------------------------//
+
+//Forget a Replica that Left the Cluster
+func (d *Detector) Remove(name string) {
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	delete(d.windows, name)
+	delete(d.down, name)
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Caller Holds the Lock
+func (d *Detector) phi(name string, now time.Time) float64 {
+
+	window, found := d.windows[name]
+	if !found {
+		return math.Inf(1)
+	}
+
+	mean := window.sum / float64(len(window.intervals))
+	if mean <= 0 {
+		return math.Inf(1)
+	}
+
+	return phiFactor * now.Sub(window.last).Seconds() / mean
+}
+
+//---------------------------------------------------------------------------//
+
+func (w *arrivalWindow) add(interval float64) {
+
+	w.intervals = append(w.intervals, interval)
+	w.sum += interval
+
+	if len(w.intervals) > windowSize {
+		w.sum -= w.intervals[0]
+		w.intervals = w.intervals[1:]
+	}
+
+}
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,207 @@
+package Gossip
+
+import (
+	"../Protobuf"
+	"github.com/golang/protobuf/proto"
+	"sort"
+	"sync"
+)
+
+//---------------------------------------------------------------------------//
+
+//Every Replica's Latest Known Endpoint State. A Record is Only Ever Replaced by a
+//Newer One - Higher Generation, or Same Generation and Higher Version - so Views
+//Exchanged in Any Order Converge
+type Table struct {
+	Self string
+
+	mtx    sync.Mutex
+	states map[string]*cassandra.EndpointState
+}
+
+//---------------------------------------------------------------------------//
+
+func New(self *cassandra.EndpointState) *Table {
+
+	table := new(Table)
+	table.Self = self.GetName()
+	table.states = make(map[string]*cassandra.EndpointState)
+
+	table.states[table.Self] = proto.Clone(self).(*cassandra.EndpointState)
+
+	return table
+}
+
+//---------------------------------------------------------------------------//
+
+//Advance this Replica's Own Version, Once per Gossip Round
+func (t *Table) Beat() {
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.states[t.Self].Version++
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Change this Replica's Own Status (and Tokens, if Given)
+func (t *Table) SetStatus(status cassandra.EndpointState_Status, tokens []int64) {
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	self := t.states[t.Self]
+	self.Status = status
+	if status != cassandra.EndpointState_JOINING {
+		self.Replacing = "" //A Replacement Only Matters While JOINING
+	}
+	if tokens != nil {
+		self.Tokens = append([]int64{}, tokens...)
+	}
+	self.Version++
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Change this Replica's Own Address
+func (t *Table) SetAddress(ip string, port string, grpcPort string) {
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	self := t.states[t.Self]
+	self.Ip = ip
+	self.Port = port
+	self.GrpcPort = grpcPort
+	self.Version++
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Change this Replica's Own Datacenter and Rack
+func (t *Table) SetLocation(datacenter string, rack string) {
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	self := t.states[t.Self]
+	self.Datacenter = datacenter
+	self.Rack = rack
+	self.Version++
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Copy of Every Record, Ours Included - the Body of a Gossip Message
+func (t *Table) Snapshot() []*cassandra.EndpointState {
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	states := make([]*cassandra.EndpointState, 0, len(t.states))
+	for _, state := range t.states {
+		states = append(states, proto.Clone(state).(*cassandra.EndpointState))
+	}
+
+	sort.Slice(states, func(i, j int) bool { return states[i].GetName() < states[j].GetName() })
+
+	return states
+}
+
+//---------------------------------------------------------------------------//
+
+//Take the Records Newer than Ours; Returns Them so the Caller Can Apply the Changes (a Newer
+//Version is Also a Heartbeat). Records About this Replica are Ignored - Only it Changes its Own Record
+func (t *Table) Merge(states []*cassandra.EndpointState) []*cassandra.EndpointState {
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	changed := []*cassandra.EndpointState{}
+	for _, state := range states {
+
+		if state.GetName() == "" || state.GetName() == t.Self {
+			continue
+		}
+
+		if current, found := t.states[state.GetName()]; found && !Newer(state, current) {
+			continue
+		}
+
+		t.states[state.GetName()] = proto.Clone(state).(*cassandra.EndpointState)
+		changed = append(changed, proto.Clone(state).(*cassandra.EndpointState))
+
+	}
+
+	return changed
+}
+
+//---------------------------------------------------------------------------//
+
+//True if a Supersedes b
+func Newer(a *cassandra.EndpointState, b *cassandra.EndpointState) bool {
+
+	if a.GetGeneration() != b.GetGeneration() {
+		return a.GetGeneration() > b.GetGeneration()
+	}
+
+	return a.GetVersion() > b.GetVersion()
+}
+
+//---------------------------------------------------------------------------//
+
+func (t *Table) Get(name string) (*cassandra.EndpointState, bool) {
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	state, found := t.states[name]
+	if !found {
+		return nil, false
+	}
+
+	return proto.Clone(state).(*cassandra.EndpointState), true
+}
+
+//---------------------------------------------------------------------------//
+
+//Announce on a Dead Replica's Behalf that it Has LEFT - Once it is Replaced. The Record Keeps
+//its Generation, so the Replica Restarting (a Newer Generation) Still Supersedes it
+func (t *Table) MarkLeft(name string) bool {
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	state, found := t.states[name]
+	if !found || name == t.Self {
+		return false
+	}
+
+	state.Status = cassandra.EndpointState_LEFT
+	state.Version++
+
+	return true
+}
+
+//---------------------------------------------------------------------------//
+
+//Drop a Replica's Record (Once it Has Left the Cluster)
+func (t *Table) Remove(name string) {
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if name == t.Self {
+		return
+	}
+
+	delete(t.states, name)
+
+}
+
+//---------------------------------------------------------------------------//
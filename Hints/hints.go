@@ -0,0 +1,368 @@
+package Hints
+
+import (
+	"../Protobuf"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const fileSuffix = ".hints"
+const recordHeaderSize = 8 //4-Byte Length + 4-Byte CRC32 of the Payload
+const maxRecordSize = 64 << 20
+
+//Hint Log Errors
+var ErrCorruptRecord = errors.New("hint record checksum mismatch")
+var ErrRecordTooLarge = errors.New("hint exceeds the maximum hint record size")
+
+//Durable Hints; One Append-Only Log File per Target Replica
+type Store struct {
+	Dir string
+
+	mtx   sync.Mutex
+	hints map[string][]*cassandra.Hint //Target Replica -> Hints in Log Order
+}
+
+//---------------------------------------------------------------------------//
+
+//Open the Hint Directory (Creating it if Needed) and Replay Every Hint Log
+func Open(dir string) (*Store, error) {
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	store := new(Store)
+	store.Dir = dir
+	store.hints = make(map[string][]*cassandra.Hint)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"+fileSuffix))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fileName := range files {
+
+		target := strings.TrimSuffix(filepath.Base(fileName), fileSuffix)
+
+		hints, err := readLog(fileName)
+
+		//Rewrite a Damaged Log, so New Records are Not Appended After the Damage
+		if err != nil {
+			fmt.Println("Hint Log:", fileName, err, "-", len(hints), "Hints Recovered")
+			if err := store.rewriteLog(target, hints); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(hints) > 0 {
+			store.hints[target] = hints
+		}
+
+	}
+
+	return store, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Append the Hint to its Target's Log; it is on Disk Before Add Returns. A Hint Too Large to be
+//Replayed is Refused
+func (s *Store) Add(hint *cassandra.Hint) error {
+
+	record, err := encodeRecord(hint)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	fileName := s.logFile(hint.GetTargetReplica())
+
+	_, statErr := os.Stat(fileName)
+	created := os.IsNotExist(statErr)
+
+	fileId, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer fileId.Close()
+
+	if _, err := fileId.Write(record); err != nil {
+		return err
+	}
+
+	if err := fileId.Sync(); err != nil {
+		return err
+	}
+
+	//A New Log is Only Durable Once its Directory Entry is
+	if created {
+		if err := syncDir(s.Dir); err != nil {
+			return err
+		}
+	}
+
+	s.hints[hint.GetTargetReplica()] = append(s.hints[hint.GetTargetReplica()], hint)
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Hints Waiting for the Target Replica, Oldest First
+func (s *Store) Pending(target string) []*cassandra.Hint {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return append([]*cassandra.Hint{}, s.hints[target]...)
+}
+
+//---------------------------------------------------------------------------//
+
+//Targets with at Least One Pending Hint
+func (s *Store) Targets() []string {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	targets := make([]string, 0, len(s.hints))
+	for target := range s.hints {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+
+	return targets
+}
+
+//---------------------------------------------------------------------------//
+
+//Drop the Given Hints (as Returned by Pending) and Rewrite the Target's Log
+func (s *Store) Remove(target string, done []*cassandra.Hint) error {
+
+	if len(done) == 0 {
+		return nil
+	}
+
+	doneSet := make(map[*cassandra.Hint]bool)
+	for _, hint := range done {
+		doneSet[hint] = true
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	remaining := []*cassandra.Hint{}
+	for _, hint := range s.hints[target] {
+		if !doneSet[hint] {
+			remaining = append(remaining, hint)
+		}
+	}
+
+	if err := s.rewriteLog(target, remaining); err != nil {
+		return err
+	}
+
+	if len(remaining) == 0 {
+		delete(s.hints, target)
+	} else {
+		s.hints[target] = remaining
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Drop Hints Logged Before the Cutoff (Unix Seconds); Returns How Many were Dropped
+func (s *Store) Expire(cutoff int64) (int, error) {
+
+	expired := 0
+
+	for _, target := range s.Targets() {
+
+		old := []*cassandra.Hint{}
+		for _, hint := range s.Pending(target) {
+			if hint.GetCreated() < cutoff {
+				old = append(old, hint)
+			}
+		}
+
+		if err := s.Remove(target, old); err != nil {
+			return expired, err
+		}
+
+		expired += len(old)
+
+	}
+
+	return expired, nil
+}
+
+//---------------------------------------------------------------------------//
+
+func (s *Store) logFile(target string) string {
+	return filepath.Join(s.Dir, target+fileSuffix)
+}
+
+//---------------------------------------------------------------------------//
+
+//Replace the Log Atomically: Write a Temporary File, Sync it, then Rename it Over the Old Log;
+//Caller Holds the Lock
+func (s *Store) rewriteLog(target string, hints []*cassandra.Hint) error {
+
+	fileName := s.logFile(target)
+
+	if len(hints) == 0 {
+		if err := os.Remove(fileName); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	tmpFile, err := ioutil.TempFile(s.Dir, target+fileSuffix+".tmp")
+	if err != nil {
+		return err
+	}
+
+	for _, hint := range hints {
+
+		record, err := encodeRecord(hint)
+		if err == nil {
+			_, err = tmpFile.Write(record)
+		}
+
+		if err != nil {
+			tmpFile.Close()
+			os.Remove(tmpFile.Name())
+			return err
+		}
+
+	}
+
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+
+	if err := os.Rename(tmpFile.Name(), fileName); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+
+	//The Rename is Only Durable Once the Directory is Synced
+	return syncDir(s.Dir)
+}
+
+//---------------------------------------------------------------------------//
+
+func syncDir(dir string) error {
+
+	dirId, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer dirId.Close()
+
+	return dirId.Sync()
+}
+
+//---------------------------------------------------------------------------//
+
+//Record: 4-Byte Big-Endian Payload Length, 4-Byte CRC32 of the Payload, Marshalled Hint
+func encodeRecord(hint *cassandra.Hint) ([]byte, error) {
+
+	payload, err := proto.Marshal(hint)
+	if err != nil {
+		return nil, err
+	}
+
+	//readLog Treats a Larger Record as Damage
+	if len(payload) > maxRecordSize {
+		return nil, ErrRecordTooLarge
+	}
+
+	record := make([]byte, recordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(payload))
+	copy(record[recordHeaderSize:], payload)
+
+	return record, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Read Every Intact Record; a Record Failing its Checksum is Skipped, and a Torn
+//Record at the End (Crash Mid-Append) Ends the Log
+func readLog(fileName string) ([]*cassandra.Hint, error) {
+
+	fileId, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer fileId.Close()
+
+	hints := []*cassandra.Hint{}
+	header := make([]byte, recordHeaderSize)
+	var logErr error
+
+	for {
+
+		if _, err := io.ReadFull(fileId, header); err != nil {
+			if err != io.EOF {
+				logErr = io.ErrUnexpectedEOF
+			}
+			break
+		}
+
+		size := binary.BigEndian.Uint32(header[0:4])
+		if size > maxRecordSize {
+			logErr = ErrCorruptRecord
+			break
+		}
+
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(fileId, payload); err != nil {
+			logErr = io.ErrUnexpectedEOF
+			break
+		}
+
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:8]) {
+			logErr = ErrCorruptRecord
+			continue
+		}
+
+		hint := new(cassandra.Hint)
+		if err := proto.Unmarshal(payload, hint); err != nil {
+			logErr = ErrCorruptRecord
+			continue
+		}
+
+		hints = append(hints, hint)
+
+	}
+
+	return hints, logErr
+}
+
+//---------------------------------------------------------------------------//
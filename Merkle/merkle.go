@@ -0,0 +1,155 @@
+package Merkle
+
+import (
+	"bytes"
+	"crypto/md5"
+	"errors"
+	"math/bits"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const DefaultDepth = 10 //1024 Leaves per Token Range
+const MaxDepth = 20
+
+//Trees Built with Different Ranges or Depths Cannot be Compared
+var ErrShapeMismatch = errors.New("merkle trees cover different ranges or depths")
+
+//Hash Tree over the Token Range (Start, End]; Start == End Covers the Whole Ring.
+//The Range is Split into 2^Depth Equal Leaves; a Leaf Hash is the XOR of its Rows'
+//Hashes (so Rows Can be Added in Any Order) and an Inner Node is the MD5 of its Children
+type Tree struct {
+	Start int64
+	End   int64
+	Depth int
+
+	Hashes [][]byte //Heap Order: Root at 0, Children of i at 2i+1 and 2i+2, Leaves Last
+}
+
+//---------------------------------------------------------------------------//
+
+func New(start int64, end int64, depth int) *Tree {
+
+	if depth < 0 || depth > MaxDepth {
+		depth = DefaultDepth
+	}
+
+	tree := new(Tree)
+	tree.Start = start
+	tree.End = end
+	tree.Depth = depth
+	tree.Hashes = make([][]byte, (2<<uint(depth))-1)
+
+	for i := range tree.Hashes {
+		tree.Hashes[i] = make([]byte, md5.Size)
+	}
+
+	return tree
+}
+
+//---------------------------------------------------------------------------//
+
+//True if the Token Lies in (start, end], Wrapping Around the Ring
+func InRange(token int64, start int64, end int64) bool {
+
+	if start < end {
+		return token > start && token <= end
+	}
+
+	if start > end {
+		return token > start || token <= end
+	}
+
+	return true
+}
+
+//---------------------------------------------------------------------------//
+
+func (t *Tree) Leaves() int {
+	return 1 << uint(t.Depth)
+}
+
+//---------------------------------------------------------------------------//
+
+//Leaf Holding the Token; the Token Must be in the Tree's Range
+func (t *Tree) Leaf(token int64) int {
+
+	leaves := uint64(t.Leaves())
+	offset := uint64(token - t.Start - 1) //Distance into the Range, Wrapping
+	width := uint64(t.End - t.Start)      //0 Means the Whole Ring (2^64)
+
+	hi, lo := bits.Mul64(offset, leaves)
+	if width == 0 {
+		return int(hi)
+	}
+
+	leaf, _ := bits.Div64(hi, lo, width)
+
+	return int(leaf)
+}
+
+//---------------------------------------------------------------------------//
+
+//Add a Row; digest Identifies its Version (Value and Timestamp). Rows Outside the Range are Ignored
+func (t *Tree) Add(token int64, key []byte, digest []byte) {
+
+	if !InRange(token, t.Start, t.End) {
+		return
+	}
+
+	rowHash := md5.Sum(append(append([]byte{}, key...), digest...))
+
+	leafHash := t.Hashes[t.Leaves()-1+t.Leaf(token)]
+	for i := range leafHash {
+		leafHash[i] ^= rowHash[i]
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Compute the Inner Nodes Once Every Row is Added
+func (t *Tree) Build() {
+
+	for i := t.Leaves() - 2; i >= 0; i-- {
+		nodeHash := md5.Sum(append(append([]byte{}, t.Hashes[2*i+1]...), t.Hashes[2*i+2]...))
+		t.Hashes[i] = nodeHash[:]
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Leaves Whose Hashes Differ; Subtrees with Equal Hashes are Skipped
+func Difference(a *Tree, b *Tree) ([]int, error) {
+
+	if a.Start != b.Start || a.End != b.End || a.Depth != b.Depth || len(a.Hashes) != len(b.Hashes) {
+		return nil, ErrShapeMismatch
+	}
+
+	leaves := []int{}
+	firstLeaf := a.Leaves() - 1
+
+	pending := []int{0}
+	for len(pending) > 0 {
+
+		node := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if bytes.Equal(a.Hashes[node], b.Hashes[node]) {
+			continue
+		}
+
+		if node >= firstLeaf {
+			leaves = append(leaves, node-firstLeaf)
+		} else {
+			pending = append(pending, 2*node+2, 2*node+1)
+		}
+
+	}
+
+	return leaves, nil
+}
+
+//---------------------------------------------------------------------------//
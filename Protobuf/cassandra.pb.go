@@ -4,9 +4,13 @@
 package cassandra
 
 import (
+	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -19,23 +23,41 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type RequestParameter_Consistency int32
 
 const (
-	RequestParameter_ONE    RequestParameter_Consistency = 0
-	RequestParameter_QUORUM RequestParameter_Consistency = 1
+	RequestParameter_ONE          RequestParameter_Consistency = 0
+	RequestParameter_QUORUM       RequestParameter_Consistency = 1
+	RequestParameter_TWO          RequestParameter_Consistency = 2
+	RequestParameter_THREE        RequestParameter_Consistency = 3
+	RequestParameter_ALL          RequestParameter_Consistency = 4
+	RequestParameter_ANY          RequestParameter_Consistency = 5
+	RequestParameter_LOCAL_QUORUM RequestParameter_Consistency = 6
+	RequestParameter_EACH_QUORUM  RequestParameter_Consistency = 7
 )
 
 var RequestParameter_Consistency_name = map[int32]string{
 	0: "ONE",
 	1: "QUORUM",
+	2: "TWO",
+	3: "THREE",
+	4: "ALL",
+	5: "ANY",
+	6: "LOCAL_QUORUM",
+	7: "EACH_QUORUM",
 }
 
 var RequestParameter_Consistency_value = map[string]int32{
-	"ONE":    0,
-	"QUORUM": 1,
+	"ONE":          0,
+	"QUORUM":       1,
+	"TWO":          2,
+	"THREE":        3,
+	"ALL":          4,
+	"ANY":          5,
+	"LOCAL_QUORUM": 6,
+	"EACH_QUORUM":  7,
 }
 
 func (x RequestParameter_Consistency) String() string {
@@ -49,18 +71,33 @@ func (RequestParameter_Consistency) EnumDescriptor() ([]byte, []int) {
 type ClientRead_Consistency int32
 
 const (
-	ClientRead_ONE    ClientRead_Consistency = 0
-	ClientRead_QUORUM ClientRead_Consistency = 1
+	ClientRead_ONE          ClientRead_Consistency = 0
+	ClientRead_QUORUM       ClientRead_Consistency = 1
+	ClientRead_TWO          ClientRead_Consistency = 2
+	ClientRead_THREE        ClientRead_Consistency = 3
+	ClientRead_ALL          ClientRead_Consistency = 4
+	ClientRead_LOCAL_QUORUM ClientRead_Consistency = 5
+	ClientRead_EACH_QUORUM  ClientRead_Consistency = 6
 )
 
 var ClientRead_Consistency_name = map[int32]string{
 	0: "ONE",
 	1: "QUORUM",
+	2: "TWO",
+	3: "THREE",
+	4: "ALL",
+	5: "LOCAL_QUORUM",
+	6: "EACH_QUORUM",
 }
 
 var ClientRead_Consistency_value = map[string]int32{
-	"ONE":    0,
-	"QUORUM": 1,
+	"ONE":          0,
+	"QUORUM":       1,
+	"TWO":          2,
+	"THREE":        3,
+	"ALL":          4,
+	"LOCAL_QUORUM": 5,
+	"EACH_QUORUM":  6,
 }
 
 func (x ClientRead_Consistency) String() string {
@@ -71,6 +108,37 @@ func (ClientRead_Consistency) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_32c4df2e0eaa2354, []int{3, 0}
 }
 
+type EndpointState_Status int32
+
+const (
+	EndpointState_NORMAL  EndpointState_Status = 0
+	EndpointState_JOINING EndpointState_Status = 1
+	EndpointState_LEAVING EndpointState_Status = 2
+	EndpointState_LEFT    EndpointState_Status = 3
+)
+
+var EndpointState_Status_name = map[int32]string{
+	0: "NORMAL",
+	1: "JOINING",
+	2: "LEAVING",
+	3: "LEFT",
+}
+
+var EndpointState_Status_value = map[string]int32{
+	"NORMAL":  0,
+	"JOINING": 1,
+	"LEAVING": 2,
+	"LEFT":    3,
+}
+
+func (x EndpointState_Status) String() string {
+	return proto.EnumName(EndpointState_Status_name, int32(x))
+}
+
+func (EndpointState_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{16, 0}
+}
+
 type InitReplicaCluster struct {
 	AllReplica           []*InitReplicaCluster_Replica `protobuf:"bytes,1,rep,name=all_replica,json=allReplica,proto3" json:"all_replica,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
@@ -114,6 +182,9 @@ type InitReplicaCluster_Replica struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Ip                   string   `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
 	Port                 string   `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	GrpcPort             string   `protobuf:"bytes,4,opt,name=grpc_port,json=grpcPort,proto3" json:"grpc_port,omitempty"`
+	Datacenter           string   `protobuf:"bytes,5,opt,name=datacenter,proto3" json:"datacenter,omitempty"`
+	Rack                 string   `protobuf:"bytes,6,opt,name=rack,proto3" json:"rack,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -165,13 +236,35 @@ func (m *InitReplicaCluster_Replica) GetPort() string {
 	return ""
 }
 
+func (m *InitReplicaCluster_Replica) GetGrpcPort() string {
+	if m != nil {
+		return m.GrpcPort
+	}
+	return ""
+}
+
+func (m *InitReplicaCluster_Replica) GetDatacenter() string {
+	if m != nil {
+		return m.Datacenter
+	}
+	return ""
+}
+
+func (m *InitReplicaCluster_Replica) GetRack() string {
+	if m != nil {
+		return m.Rack
+	}
+	return ""
+}
+
 type RequestParameter struct {
 	OriginReplica        string                       `protobuf:"bytes,1,opt,name=originReplica,proto3" json:"originReplica,omitempty"`
-	Key                  uint32                       `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Key                  []byte                       `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	Value                string                       `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	Consistency          RequestParameter_Consistency `protobuf:"varint,4,opt,name=consistency,proto3,enum=RequestParameter_Consistency" json:"consistency,omitempty"`
 	Timestamp            *timestamp.Timestamp         `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	TimeInSeconds        int64                        `protobuf:"varint,6,opt,name=timeInSeconds,proto3" json:"timeInSeconds,omitempty"`
+	Keyspace             string                       `protobuf:"bytes,7,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -209,11 +302,11 @@ func (m *RequestParameter) GetOriginReplica() string {
 	return ""
 }
 
-func (m *RequestParameter) GetKey() uint32 {
+func (m *RequestParameter) GetKey() []byte {
 	if m != nil {
 		return m.Key
 	}
-	return 0
+	return nil
 }
 
 func (m *RequestParameter) GetValue() string {
@@ -244,13 +337,22 @@ func (m *RequestParameter) GetTimeInSeconds() int64 {
 	return 0
 }
 
+func (m *RequestParameter) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
 type Response struct {
 	OriginReplica        string   `protobuf:"bytes,1,opt,name=originReplica,proto3" json:"originReplica,omitempty"`
-	Key                  uint32   `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	Arrival              int64    `protobuf:"varint,4,opt,name=arrival,proto3" json:"arrival,omitempty"`
 	Status               bool     `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
 	RespMessage          string   `protobuf:"bytes,6,opt,name=respMessage,proto3" json:"respMessage,omitempty"`
+	Code                 uint32   `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+	Digest               []byte   `protobuf:"bytes,8,opt,name=digest,proto3" json:"digest,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -288,11 +390,11 @@ func (m *Response) GetOriginReplica() string {
 	return ""
 }
 
-func (m *Response) GetKey() uint32 {
+func (m *Response) GetKey() []byte {
 	if m != nil {
 		return m.Key
 	}
-	return 0
+	return nil
 }
 
 func (m *Response) GetValue() string {
@@ -323,9 +425,24 @@ func (m *Response) GetRespMessage() string {
 	return ""
 }
 
+func (m *Response) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *Response) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
 type ClientRead struct {
-	Key                  uint32                 `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Key                  []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Consistency          ClientRead_Consistency `protobuf:"varint,2,opt,name=consistency,proto3,enum=ClientRead_Consistency" json:"consistency,omitempty"`
+	Keyspace             string                 `protobuf:"bytes,3,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -356,11 +473,11 @@ func (m *ClientRead) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClientRead proto.InternalMessageInfo
 
-func (m *ClientRead) GetKey() uint32 {
+func (m *ClientRead) GetKey() []byte {
 	if m != nil {
 		return m.Key
 	}
-	return 0
+	return nil
 }
 
 func (m *ClientRead) GetConsistency() ClientRead_Consistency {
@@ -370,8 +487,17 @@ func (m *ClientRead) GetConsistency() ClientRead_Consistency {
 	return ClientRead_ONE
 }
 
+func (m *ClientRead) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
 type ReplicaRead struct {
-	Key                  uint32   `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Keyspace             string   `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Digest               bool     `protobuf:"varint,3,opt,name=digest,proto3" json:"digest,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -402,13 +528,153 @@ func (m *ReplicaRead) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReplicaRead proto.InternalMessageInfo
 
-func (m *ReplicaRead) GetKey() uint32 {
+func (m *ReplicaRead) GetKey() []byte {
 	if m != nil {
 		return m.Key
 	}
+	return nil
+}
+
+func (m *ReplicaRead) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ReplicaRead) GetDigest() bool {
+	if m != nil {
+		return m.Digest
+	}
+	return false
+}
+
+type ScanRequest struct {
+	StartKey             []byte   `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey               []byte   `protobuf:"bytes,2,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	Keyspace             string   `protobuf:"bytes,3,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScanRequest) Reset()         { *m = ScanRequest{} }
+func (m *ScanRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanRequest) ProtoMessage()    {}
+func (*ScanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{5}
+}
+
+func (m *ScanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRequest.Unmarshal(m, b)
+}
+func (m *ScanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRequest.Merge(m, src)
+}
+func (m *ScanRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanRequest.Size(m)
+}
+func (m *ScanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRequest proto.InternalMessageInfo
+
+func (m *ScanRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *ScanRequest) GetEndKey() []byte {
+	if m != nil {
+		return m.EndKey
+	}
+	return nil
+}
+
+func (m *ScanRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type KeyspaceDefinition struct {
+	Name                  string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReplicationFactor     uint32            `protobuf:"varint,2,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+	ReadRepairChance      float64           `protobuf:"fixed64,3,opt,name=read_repair_chance,json=readRepairChance,proto3" json:"read_repair_chance,omitempty"`
+	HintedHandoff         bool              `protobuf:"varint,4,opt,name=hinted_handoff,json=hintedHandoff,proto3" json:"hinted_handoff,omitempty"`
+	DatacenterReplication map[string]uint32 `protobuf:"bytes,5,rep,name=datacenter_replication,json=datacenterReplication,proto3" json:"datacenter_replication,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *KeyspaceDefinition) Reset()         { *m = KeyspaceDefinition{} }
+func (m *KeyspaceDefinition) String() string { return proto.CompactTextString(m) }
+func (*KeyspaceDefinition) ProtoMessage()    {}
+func (*KeyspaceDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{6}
+}
+
+func (m *KeyspaceDefinition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyspaceDefinition.Unmarshal(m, b)
+}
+func (m *KeyspaceDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyspaceDefinition.Marshal(b, m, deterministic)
+}
+func (m *KeyspaceDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyspaceDefinition.Merge(m, src)
+}
+func (m *KeyspaceDefinition) XXX_Size() int {
+	return xxx_messageInfo_KeyspaceDefinition.Size(m)
+}
+func (m *KeyspaceDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyspaceDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyspaceDefinition proto.InternalMessageInfo
+
+func (m *KeyspaceDefinition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *KeyspaceDefinition) GetReplicationFactor() uint32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
+}
+
+func (m *KeyspaceDefinition) GetReadRepairChance() float64 {
+	if m != nil {
+		return m.ReadRepairChance
+	}
 	return 0
 }
 
+func (m *KeyspaceDefinition) GetHintedHandoff() bool {
+	if m != nil {
+		return m.HintedHandoff
+	}
+	return false
+}
+
+func (m *KeyspaceDefinition) GetDatacenterReplication() map[string]uint32 {
+	if m != nil {
+		return m.DatacenterReplication
+	}
+	return nil
+}
+
 type ClientPut struct {
 	Input                *RequestParameter `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -420,7 +686,7 @@ func (m *ClientPut) Reset()         { *m = ClientPut{} }
 func (m *ClientPut) String() string { return proto.CompactTextString(m) }
 func (*ClientPut) ProtoMessage()    {}
 func (*ClientPut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32c4df2e0eaa2354, []int{5}
+	return fileDescriptor_32c4df2e0eaa2354, []int{7}
 }
 
 func (m *ClientPut) XXX_Unmarshal(b []byte) error {
@@ -459,7 +725,7 @@ func (m *ReplicaPut) Reset()         { *m = ReplicaPut{} }
 func (m *ReplicaPut) String() string { return proto.CompactTextString(m) }
 func (*ReplicaPut) ProtoMessage()    {}
 func (*ReplicaPut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32c4df2e0eaa2354, []int{6}
+	return fileDescriptor_32c4df2e0eaa2354, []int{8}
 }
 
 func (m *ReplicaPut) XXX_Unmarshal(b []byte) error {
@@ -487,337 +753,1436 @@ func (m *ReplicaPut) GetInput() *RequestParameter {
 	return nil
 }
 
-type InputRequest struct {
-	// Types that are valid to be assigned to InputRequest:
-	//	*InputRequest_InitReplica
-	//	*InputRequest_ClientRead
-	//	*InputRequest_ReplicaRead
-	//	*InputRequest_ClientPut
-	//	*InputRequest_ReplicaPut
-	//	*InputRequest_Response
-	InputRequest         isInputRequest_InputRequest `protobuf_oneof:"input_request"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+type Hint struct {
+	TargetReplica        string   `protobuf:"bytes,1,opt,name=target_replica,json=targetReplica,proto3" json:"target_replica,omitempty"`
+	Keyspace             string   `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Key                  []byte   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	TimeInSeconds        int64    `protobuf:"varint,5,opt,name=timeInSeconds,proto3" json:"timeInSeconds,omitempty"`
+	Created              int64    `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InputRequest) Reset()         { *m = InputRequest{} }
-func (m *InputRequest) String() string { return proto.CompactTextString(m) }
-func (*InputRequest) ProtoMessage()    {}
-func (*InputRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32c4df2e0eaa2354, []int{7}
+func (m *Hint) Reset()         { *m = Hint{} }
+func (m *Hint) String() string { return proto.CompactTextString(m) }
+func (*Hint) ProtoMessage()    {}
+func (*Hint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{9}
 }
 
-func (m *InputRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InputRequest.Unmarshal(m, b)
+func (m *Hint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hint.Unmarshal(m, b)
 }
-func (m *InputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InputRequest.Marshal(b, m, deterministic)
+func (m *Hint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hint.Marshal(b, m, deterministic)
 }
-func (m *InputRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InputRequest.Merge(m, src)
+func (m *Hint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hint.Merge(m, src)
 }
-func (m *InputRequest) XXX_Size() int {
-	return xxx_messageInfo_InputRequest.Size(m)
+func (m *Hint) XXX_Size() int {
+	return xxx_messageInfo_Hint.Size(m)
 }
-func (m *InputRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InputRequest.DiscardUnknown(m)
+func (m *Hint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InputRequest proto.InternalMessageInfo
+var xxx_messageInfo_Hint proto.InternalMessageInfo
 
-type isInputRequest_InputRequest interface {
-	isInputRequest_InputRequest()
+func (m *Hint) GetTargetReplica() string {
+	if m != nil {
+		return m.TargetReplica
+	}
+	return ""
 }
 
-type InputRequest_InitReplica struct {
-	InitReplica *InitReplicaCluster `protobuf:"bytes,1,opt,name=init_replica,json=initReplica,proto3,oneof"`
+func (m *Hint) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
 }
 
-type InputRequest_ClientRead struct {
-	ClientRead *ClientRead `protobuf:"bytes,2,opt,name=client_read,json=clientRead,proto3,oneof"`
+func (m *Hint) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
 
-type InputRequest_ReplicaRead struct {
-	ReplicaRead *ReplicaRead `protobuf:"bytes,3,opt,name=replica_read,json=replicaRead,proto3,oneof"`
+func (m *Hint) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-type InputRequest_ClientPut struct {
-	ClientPut *ClientPut `protobuf:"bytes,4,opt,name=client_put,json=clientPut,proto3,oneof"`
+func (m *Hint) GetTimeInSeconds() int64 {
+	if m != nil {
+		return m.TimeInSeconds
+	}
+	return 0
 }
 
-type InputRequest_ReplicaPut struct {
-	ReplicaPut *ReplicaPut `protobuf:"bytes,5,opt,name=replica_put,json=replicaPut,proto3,oneof"`
+func (m *Hint) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
 }
 
-type InputRequest_Response struct {
-	Response *Response `protobuf:"bytes,6,opt,name=response,proto3,oneof"`
+type TokenRange struct {
+	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*InputRequest_InitReplica) isInputRequest_InputRequest() {}
-
-func (*InputRequest_ClientRead) isInputRequest_InputRequest() {}
-
-func (*InputRequest_ReplicaRead) isInputRequest_InputRequest() {}
-
-func (*InputRequest_ClientPut) isInputRequest_InputRequest() {}
+func (m *TokenRange) Reset()         { *m = TokenRange{} }
+func (m *TokenRange) String() string { return proto.CompactTextString(m) }
+func (*TokenRange) ProtoMessage()    {}
+func (*TokenRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{10}
+}
 
-func (*InputRequest_ReplicaPut) isInputRequest_InputRequest() {}
+func (m *TokenRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenRange.Unmarshal(m, b)
+}
+func (m *TokenRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenRange.Marshal(b, m, deterministic)
+}
+func (m *TokenRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenRange.Merge(m, src)
+}
+func (m *TokenRange) XXX_Size() int {
+	return xxx_messageInfo_TokenRange.Size(m)
+}
+func (m *TokenRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenRange.DiscardUnknown(m)
+}
 
-func (*InputRequest_Response) isInputRequest_InputRequest() {}
+var xxx_messageInfo_TokenRange proto.InternalMessageInfo
 
-func (m *InputRequest) GetInputRequest() isInputRequest_InputRequest {
+func (m *TokenRange) GetStart() int64 {
 	if m != nil {
-		return m.InputRequest
+		return m.Start
 	}
-	return nil
+	return 0
 }
 
-func (m *InputRequest) GetInitReplica() *InitReplicaCluster {
-	if x, ok := m.GetInputRequest().(*InputRequest_InitReplica); ok {
-		return x.InitReplica
+func (m *TokenRange) GetEnd() int64 {
+	if m != nil {
+		return m.End
 	}
-	return nil
+	return 0
 }
 
-func (m *InputRequest) GetClientRead() *ClientRead {
-	if x, ok := m.GetInputRequest().(*InputRequest_ClientRead); ok {
-		return x.ClientRead
-	}
-	return nil
+type MerkleTreeRequest struct {
+	Keyspace             string      `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Range                *TokenRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	Depth                uint32      `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *InputRequest) GetReplicaRead() *ReplicaRead {
-	if x, ok := m.GetInputRequest().(*InputRequest_ReplicaRead); ok {
-		return x.ReplicaRead
-	}
-	return nil
+func (m *MerkleTreeRequest) Reset()         { *m = MerkleTreeRequest{} }
+func (m *MerkleTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*MerkleTreeRequest) ProtoMessage()    {}
+func (*MerkleTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{11}
 }
 
-func (m *InputRequest) GetClientPut() *ClientPut {
-	if x, ok := m.GetInputRequest().(*InputRequest_ClientPut); ok {
-		return x.ClientPut
+func (m *MerkleTreeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerkleTreeRequest.Unmarshal(m, b)
+}
+func (m *MerkleTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerkleTreeRequest.Marshal(b, m, deterministic)
+}
+func (m *MerkleTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleTreeRequest.Merge(m, src)
+}
+func (m *MerkleTreeRequest) XXX_Size() int {
+	return xxx_messageInfo_MerkleTreeRequest.Size(m)
+}
+func (m *MerkleTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleTreeRequest proto.InternalMessageInfo
+
+func (m *MerkleTreeRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
 	}
-	return nil
+	return ""
 }
 
-func (m *InputRequest) GetReplicaPut() *ReplicaPut {
-	if x, ok := m.GetInputRequest().(*InputRequest_ReplicaPut); ok {
-		return x.ReplicaPut
+func (m *MerkleTreeRequest) GetRange() *TokenRange {
+	if m != nil {
+		return m.Range
 	}
 	return nil
 }
 
-func (m *InputRequest) GetResponse() *Response {
+func (m *MerkleTreeRequest) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type MerkleTree struct {
+	Range                *TokenRange `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	Depth                uint32      `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	Hashes               [][]byte    `protobuf:"bytes,3,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *MerkleTree) Reset()         { *m = MerkleTree{} }
+func (m *MerkleTree) String() string { return proto.CompactTextString(m) }
+func (*MerkleTree) ProtoMessage()    {}
+func (*MerkleTree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{12}
+}
+
+func (m *MerkleTree) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerkleTree.Unmarshal(m, b)
+}
+func (m *MerkleTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerkleTree.Marshal(b, m, deterministic)
+}
+func (m *MerkleTree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleTree.Merge(m, src)
+}
+func (m *MerkleTree) XXX_Size() int {
+	return xxx_messageInfo_MerkleTree.Size(m)
+}
+func (m *MerkleTree) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleTree.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleTree proto.InternalMessageInfo
+
+func (m *MerkleTree) GetRange() *TokenRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *MerkleTree) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *MerkleTree) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type RepairRowsRequest struct {
+	Keyspace             string      `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Range                *TokenRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	Depth                uint32      `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Leaves               []uint32    `protobuf:"varint,4,rep,packed,name=leaves,proto3" json:"leaves,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RepairRowsRequest) Reset()         { *m = RepairRowsRequest{} }
+func (m *RepairRowsRequest) String() string { return proto.CompactTextString(m) }
+func (*RepairRowsRequest) ProtoMessage()    {}
+func (*RepairRowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{13}
+}
+
+func (m *RepairRowsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairRowsRequest.Unmarshal(m, b)
+}
+func (m *RepairRowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairRowsRequest.Marshal(b, m, deterministic)
+}
+func (m *RepairRowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairRowsRequest.Merge(m, src)
+}
+func (m *RepairRowsRequest) XXX_Size() int {
+	return xxx_messageInfo_RepairRowsRequest.Size(m)
+}
+func (m *RepairRowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairRowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairRowsRequest proto.InternalMessageInfo
+
+func (m *RepairRowsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *RepairRowsRequest) GetRange() *TokenRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *RepairRowsRequest) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *RepairRowsRequest) GetLeaves() []uint32 {
+	if m != nil {
+		return m.Leaves
+	}
+	return nil
+}
+
+type RepairRows struct {
+	Rows                 []*RequestParameter `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RepairRows) Reset()         { *m = RepairRows{} }
+func (m *RepairRows) String() string { return proto.CompactTextString(m) }
+func (*RepairRows) ProtoMessage()    {}
+func (*RepairRows) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{14}
+}
+
+func (m *RepairRows) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairRows.Unmarshal(m, b)
+}
+func (m *RepairRows) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairRows.Marshal(b, m, deterministic)
+}
+func (m *RepairRows) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairRows.Merge(m, src)
+}
+func (m *RepairRows) XXX_Size() int {
+	return xxx_messageInfo_RepairRows.Size(m)
+}
+func (m *RepairRows) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairRows.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairRows proto.InternalMessageInfo
+
+func (m *RepairRows) GetRows() []*RequestParameter {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+type RepairRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepairRequest) Reset()         { *m = RepairRequest{} }
+func (m *RepairRequest) String() string { return proto.CompactTextString(m) }
+func (*RepairRequest) ProtoMessage()    {}
+func (*RepairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{15}
+}
+
+func (m *RepairRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairRequest.Unmarshal(m, b)
+}
+func (m *RepairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairRequest.Marshal(b, m, deterministic)
+}
+func (m *RepairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairRequest.Merge(m, src)
+}
+func (m *RepairRequest) XXX_Size() int {
+	return xxx_messageInfo_RepairRequest.Size(m)
+}
+func (m *RepairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairRequest proto.InternalMessageInfo
+
+func (m *RepairRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type EndpointState struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ip                   string               `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port                 string               `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	GrpcPort             string               `protobuf:"bytes,4,opt,name=grpc_port,json=grpcPort,proto3" json:"grpc_port,omitempty"`
+	Generation           int64                `protobuf:"varint,5,opt,name=generation,proto3" json:"generation,omitempty"`
+	Version              uint64               `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Status               EndpointState_Status `protobuf:"varint,7,opt,name=status,proto3,enum=EndpointState_Status" json:"status,omitempty"`
+	Tokens               []int64              `protobuf:"varint,8,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	Replacing            string               `protobuf:"bytes,9,opt,name=replacing,proto3" json:"replacing,omitempty"`
+	Datacenter           string               `protobuf:"bytes,10,opt,name=datacenter,proto3" json:"datacenter,omitempty"`
+	Rack                 string               `protobuf:"bytes,11,opt,name=rack,proto3" json:"rack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *EndpointState) Reset()         { *m = EndpointState{} }
+func (m *EndpointState) String() string { return proto.CompactTextString(m) }
+func (*EndpointState) ProtoMessage()    {}
+func (*EndpointState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{16}
+}
+
+func (m *EndpointState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EndpointState.Unmarshal(m, b)
+}
+func (m *EndpointState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EndpointState.Marshal(b, m, deterministic)
+}
+func (m *EndpointState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndpointState.Merge(m, src)
+}
+func (m *EndpointState) XXX_Size() int {
+	return xxx_messageInfo_EndpointState.Size(m)
+}
+func (m *EndpointState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndpointState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndpointState proto.InternalMessageInfo
+
+func (m *EndpointState) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EndpointState) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *EndpointState) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *EndpointState) GetGrpcPort() string {
+	if m != nil {
+		return m.GrpcPort
+	}
+	return ""
+}
+
+func (m *EndpointState) GetGeneration() int64 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
+func (m *EndpointState) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EndpointState) GetStatus() EndpointState_Status {
+	if m != nil {
+		return m.Status
+	}
+	return EndpointState_NORMAL
+}
+
+func (m *EndpointState) GetTokens() []int64 {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *EndpointState) GetReplacing() string {
+	if m != nil {
+		return m.Replacing
+	}
+	return ""
+}
+
+func (m *EndpointState) GetDatacenter() string {
+	if m != nil {
+		return m.Datacenter
+	}
+	return ""
+}
+
+func (m *EndpointState) GetRack() string {
+	if m != nil {
+		return m.Rack
+	}
+	return ""
+}
+
+type Gossip struct {
+	States               []*EndpointState `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Gossip) Reset()         { *m = Gossip{} }
+func (m *Gossip) String() string { return proto.CompactTextString(m) }
+func (*Gossip) ProtoMessage()    {}
+func (*Gossip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{17}
+}
+
+func (m *Gossip) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Gossip.Unmarshal(m, b)
+}
+func (m *Gossip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Gossip.Marshal(b, m, deterministic)
+}
+func (m *Gossip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gossip.Merge(m, src)
+}
+func (m *Gossip) XXX_Size() int {
+	return xxx_messageInfo_Gossip.Size(m)
+}
+func (m *Gossip) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gossip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gossip proto.InternalMessageInfo
+
+func (m *Gossip) GetStates() []*EndpointState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+type DecommissionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecommissionRequest) Reset()         { *m = DecommissionRequest{} }
+func (m *DecommissionRequest) String() string { return proto.CompactTextString(m) }
+func (*DecommissionRequest) ProtoMessage()    {}
+func (*DecommissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{18}
+}
+
+func (m *DecommissionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionRequest.Unmarshal(m, b)
+}
+func (m *DecommissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionRequest.Marshal(b, m, deterministic)
+}
+func (m *DecommissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionRequest.Merge(m, src)
+}
+func (m *DecommissionRequest) XXX_Size() int {
+	return xxx_messageInfo_DecommissionRequest.Size(m)
+}
+func (m *DecommissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionRequest proto.InternalMessageInfo
+
+type SchemaRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SchemaRequest) Reset()         { *m = SchemaRequest{} }
+func (m *SchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*SchemaRequest) ProtoMessage()    {}
+func (*SchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{19}
+}
+
+func (m *SchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchemaRequest.Unmarshal(m, b)
+}
+func (m *SchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *SchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaRequest.Merge(m, src)
+}
+func (m *SchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_SchemaRequest.Size(m)
+}
+func (m *SchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaRequest proto.InternalMessageInfo
+
+type Schema struct {
+	Keyspaces            []*KeyspaceDefinition `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Schema) Reset()         { *m = Schema{} }
+func (m *Schema) String() string { return proto.CompactTextString(m) }
+func (*Schema) ProtoMessage()    {}
+func (*Schema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{20}
+}
+
+func (m *Schema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Schema.Unmarshal(m, b)
+}
+func (m *Schema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Schema.Marshal(b, m, deterministic)
+}
+func (m *Schema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema.Merge(m, src)
+}
+func (m *Schema) XXX_Size() int {
+	return xxx_messageInfo_Schema.Size(m)
+}
+func (m *Schema) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema proto.InternalMessageInfo
+
+func (m *Schema) GetKeyspaces() []*KeyspaceDefinition {
+	if m != nil {
+		return m.Keyspaces
+	}
+	return nil
+}
+
+type InputRequest struct {
+	// Types that are valid to be assigned to InputRequest:
+	//	*InputRequest_InitReplica
+	//	*InputRequest_ClientRead
+	//	*InputRequest_ReplicaRead
+	//	*InputRequest_ClientPut
+	//	*InputRequest_ReplicaPut
+	//	*InputRequest_Response
+	//	*InputRequest_KeyspaceDefinition
+	//	*InputRequest_MerkleTreeRequest
+	//	*InputRequest_MerkleTree
+	//	*InputRequest_RepairRowsRequest
+	//	*InputRequest_RepairRows
+	//	*InputRequest_Gossip
+	//	*InputRequest_SchemaRequest
+	//	*InputRequest_Schema
+	InputRequest         isInputRequest_InputRequest `protobuf_oneof:"input_request"`
+	RequestId            uint64                      `protobuf:"varint,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *InputRequest) Reset()         { *m = InputRequest{} }
+func (m *InputRequest) String() string { return proto.CompactTextString(m) }
+func (*InputRequest) ProtoMessage()    {}
+func (*InputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c4df2e0eaa2354, []int{21}
+}
+
+func (m *InputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InputRequest.Unmarshal(m, b)
+}
+func (m *InputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InputRequest.Marshal(b, m, deterministic)
+}
+func (m *InputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InputRequest.Merge(m, src)
+}
+func (m *InputRequest) XXX_Size() int {
+	return xxx_messageInfo_InputRequest.Size(m)
+}
+func (m *InputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InputRequest proto.InternalMessageInfo
+
+type isInputRequest_InputRequest interface {
+	isInputRequest_InputRequest()
+}
+
+type InputRequest_InitReplica struct {
+	InitReplica *InitReplicaCluster `protobuf:"bytes,1,opt,name=init_replica,json=initReplica,proto3,oneof"`
+}
+
+type InputRequest_ClientRead struct {
+	ClientRead *ClientRead `protobuf:"bytes,2,opt,name=client_read,json=clientRead,proto3,oneof"`
+}
+
+type InputRequest_ReplicaRead struct {
+	ReplicaRead *ReplicaRead `protobuf:"bytes,3,opt,name=replica_read,json=replicaRead,proto3,oneof"`
+}
+
+type InputRequest_ClientPut struct {
+	ClientPut *ClientPut `protobuf:"bytes,4,opt,name=client_put,json=clientPut,proto3,oneof"`
+}
+
+type InputRequest_ReplicaPut struct {
+	ReplicaPut *ReplicaPut `protobuf:"bytes,5,opt,name=replica_put,json=replicaPut,proto3,oneof"`
+}
+
+type InputRequest_Response struct {
+	Response *Response `protobuf:"bytes,6,opt,name=response,proto3,oneof"`
+}
+
+type InputRequest_KeyspaceDefinition struct {
+	KeyspaceDefinition *KeyspaceDefinition `protobuf:"bytes,8,opt,name=keyspace_definition,json=keyspaceDefinition,proto3,oneof"`
+}
+
+type InputRequest_MerkleTreeRequest struct {
+	MerkleTreeRequest *MerkleTreeRequest `protobuf:"bytes,9,opt,name=merkle_tree_request,json=merkleTreeRequest,proto3,oneof"`
+}
+
+type InputRequest_MerkleTree struct {
+	MerkleTree *MerkleTree `protobuf:"bytes,10,opt,name=merkle_tree,json=merkleTree,proto3,oneof"`
+}
+
+type InputRequest_RepairRowsRequest struct {
+	RepairRowsRequest *RepairRowsRequest `protobuf:"bytes,11,opt,name=repair_rows_request,json=repairRowsRequest,proto3,oneof"`
+}
+
+type InputRequest_RepairRows struct {
+	RepairRows *RepairRows `protobuf:"bytes,12,opt,name=repair_rows,json=repairRows,proto3,oneof"`
+}
+
+type InputRequest_Gossip struct {
+	Gossip *Gossip `protobuf:"bytes,13,opt,name=gossip,proto3,oneof"`
+}
+
+type InputRequest_SchemaRequest struct {
+	SchemaRequest *SchemaRequest `protobuf:"bytes,14,opt,name=schema_request,json=schemaRequest,proto3,oneof"`
+}
+
+type InputRequest_Schema struct {
+	Schema *Schema `protobuf:"bytes,15,opt,name=schema,proto3,oneof"`
+}
+
+func (*InputRequest_InitReplica) isInputRequest_InputRequest() {}
+
+func (*InputRequest_ClientRead) isInputRequest_InputRequest() {}
+
+func (*InputRequest_ReplicaRead) isInputRequest_InputRequest() {}
+
+func (*InputRequest_ClientPut) isInputRequest_InputRequest() {}
+
+func (*InputRequest_ReplicaPut) isInputRequest_InputRequest() {}
+
+func (*InputRequest_Response) isInputRequest_InputRequest() {}
+
+func (*InputRequest_KeyspaceDefinition) isInputRequest_InputRequest() {}
+
+func (*InputRequest_MerkleTreeRequest) isInputRequest_InputRequest() {}
+
+func (*InputRequest_MerkleTree) isInputRequest_InputRequest() {}
+
+func (*InputRequest_RepairRowsRequest) isInputRequest_InputRequest() {}
+
+func (*InputRequest_RepairRows) isInputRequest_InputRequest() {}
+
+func (*InputRequest_Gossip) isInputRequest_InputRequest() {}
+
+func (*InputRequest_SchemaRequest) isInputRequest_InputRequest() {}
+
+func (*InputRequest_Schema) isInputRequest_InputRequest() {}
+
+func (m *InputRequest) GetInputRequest() isInputRequest_InputRequest {
+	if m != nil {
+		return m.InputRequest
+	}
+	return nil
+}
+
+func (m *InputRequest) GetInitReplica() *InitReplicaCluster {
+	if x, ok := m.GetInputRequest().(*InputRequest_InitReplica); ok {
+		return x.InitReplica
+	}
+	return nil
+}
+
+func (m *InputRequest) GetClientRead() *ClientRead {
+	if x, ok := m.GetInputRequest().(*InputRequest_ClientRead); ok {
+		return x.ClientRead
+	}
+	return nil
+}
+
+func (m *InputRequest) GetReplicaRead() *ReplicaRead {
+	if x, ok := m.GetInputRequest().(*InputRequest_ReplicaRead); ok {
+		return x.ReplicaRead
+	}
+	return nil
+}
+
+func (m *InputRequest) GetClientPut() *ClientPut {
+	if x, ok := m.GetInputRequest().(*InputRequest_ClientPut); ok {
+		return x.ClientPut
+	}
+	return nil
+}
+
+func (m *InputRequest) GetReplicaPut() *ReplicaPut {
+	if x, ok := m.GetInputRequest().(*InputRequest_ReplicaPut); ok {
+		return x.ReplicaPut
+	}
+	return nil
+}
+
+func (m *InputRequest) GetResponse() *Response {
 	if x, ok := m.GetInputRequest().(*InputRequest_Response); ok {
 		return x.Response
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*InputRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _InputRequest_OneofMarshaler, _InputRequest_OneofUnmarshaler, _InputRequest_OneofSizer, []interface{}{
+func (m *InputRequest) GetKeyspaceDefinition() *KeyspaceDefinition {
+	if x, ok := m.GetInputRequest().(*InputRequest_KeyspaceDefinition); ok {
+		return x.KeyspaceDefinition
+	}
+	return nil
+}
+
+func (m *InputRequest) GetMerkleTreeRequest() *MerkleTreeRequest {
+	if x, ok := m.GetInputRequest().(*InputRequest_MerkleTreeRequest); ok {
+		return x.MerkleTreeRequest
+	}
+	return nil
+}
+
+func (m *InputRequest) GetMerkleTree() *MerkleTree {
+	if x, ok := m.GetInputRequest().(*InputRequest_MerkleTree); ok {
+		return x.MerkleTree
+	}
+	return nil
+}
+
+func (m *InputRequest) GetRepairRowsRequest() *RepairRowsRequest {
+	if x, ok := m.GetInputRequest().(*InputRequest_RepairRowsRequest); ok {
+		return x.RepairRowsRequest
+	}
+	return nil
+}
+
+func (m *InputRequest) GetRepairRows() *RepairRows {
+	if x, ok := m.GetInputRequest().(*InputRequest_RepairRows); ok {
+		return x.RepairRows
+	}
+	return nil
+}
+
+func (m *InputRequest) GetGossip() *Gossip {
+	if x, ok := m.GetInputRequest().(*InputRequest_Gossip); ok {
+		return x.Gossip
+	}
+	return nil
+}
+
+func (m *InputRequest) GetSchemaRequest() *SchemaRequest {
+	if x, ok := m.GetInputRequest().(*InputRequest_SchemaRequest); ok {
+		return x.SchemaRequest
+	}
+	return nil
+}
+
+func (m *InputRequest) GetSchema() *Schema {
+	if x, ok := m.GetInputRequest().(*InputRequest_Schema); ok {
+		return x.Schema
+	}
+	return nil
+}
+
+func (m *InputRequest) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*InputRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
 		(*InputRequest_InitReplica)(nil),
 		(*InputRequest_ClientRead)(nil),
 		(*InputRequest_ReplicaRead)(nil),
 		(*InputRequest_ClientPut)(nil),
 		(*InputRequest_ReplicaPut)(nil),
 		(*InputRequest_Response)(nil),
+		(*InputRequest_KeyspaceDefinition)(nil),
+		(*InputRequest_MerkleTreeRequest)(nil),
+		(*InputRequest_MerkleTree)(nil),
+		(*InputRequest_RepairRowsRequest)(nil),
+		(*InputRequest_RepairRows)(nil),
+		(*InputRequest_Gossip)(nil),
+		(*InputRequest_SchemaRequest)(nil),
+		(*InputRequest_Schema)(nil),
 	}
 }
 
-func _InputRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*InputRequest)
-	// input_request
-	switch x := m.InputRequest.(type) {
-	case *InputRequest_InitReplica:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.InitReplica); err != nil {
-			return err
-		}
-	case *InputRequest_ClientRead:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ClientRead); err != nil {
-			return err
-		}
-	case *InputRequest_ReplicaRead:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ReplicaRead); err != nil {
-			return err
-		}
-	case *InputRequest_ClientPut:
-		b.EncodeVarint(4<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ClientPut); err != nil {
-			return err
-		}
-	case *InputRequest_ReplicaPut:
-		b.EncodeVarint(5<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ReplicaPut); err != nil {
-			return err
-		}
-	case *InputRequest_Response:
-		b.EncodeVarint(6<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Response); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("InputRequest.InputRequest has unexpected type %T", x)
-	}
-	return nil
-}
-
-func _InputRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*InputRequest)
-	switch tag {
-	case 1: // input_request.init_replica
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(InitReplicaCluster)
-		err := b.DecodeMessage(msg)
-		m.InputRequest = &InputRequest_InitReplica{msg}
-		return true, err
-	case 2: // input_request.client_read
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ClientRead)
-		err := b.DecodeMessage(msg)
-		m.InputRequest = &InputRequest_ClientRead{msg}
-		return true, err
-	case 3: // input_request.replica_read
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ReplicaRead)
-		err := b.DecodeMessage(msg)
-		m.InputRequest = &InputRequest_ReplicaRead{msg}
-		return true, err
-	case 4: // input_request.client_put
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ClientPut)
-		err := b.DecodeMessage(msg)
-		m.InputRequest = &InputRequest_ClientPut{msg}
-		return true, err
-	case 5: // input_request.replica_put
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ReplicaPut)
-		err := b.DecodeMessage(msg)
-		m.InputRequest = &InputRequest_ReplicaPut{msg}
-		return true, err
-	case 6: // input_request.response
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Response)
-		err := b.DecodeMessage(msg)
-		m.InputRequest = &InputRequest_Response{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _InputRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*InputRequest)
-	// input_request
-	switch x := m.InputRequest.(type) {
-	case *InputRequest_InitReplica:
-		s := proto.Size(x.InitReplica)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *InputRequest_ClientRead:
-		s := proto.Size(x.ClientRead)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *InputRequest_ReplicaRead:
-		s := proto.Size(x.ReplicaRead)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *InputRequest_ClientPut:
-		s := proto.Size(x.ClientPut)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *InputRequest_ReplicaPut:
-		s := proto.Size(x.ReplicaPut)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *InputRequest_Response:
-		s := proto.Size(x.Response)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
 func init() {
 	proto.RegisterEnum("RequestParameter_Consistency", RequestParameter_Consistency_name, RequestParameter_Consistency_value)
 	proto.RegisterEnum("ClientRead_Consistency", ClientRead_Consistency_name, ClientRead_Consistency_value)
+	proto.RegisterEnum("EndpointState_Status", EndpointState_Status_name, EndpointState_Status_value)
 	proto.RegisterType((*InitReplicaCluster)(nil), "InitReplicaCluster")
 	proto.RegisterType((*InitReplicaCluster_Replica)(nil), "InitReplicaCluster.Replica")
 	proto.RegisterType((*RequestParameter)(nil), "RequestParameter")
 	proto.RegisterType((*Response)(nil), "Response")
 	proto.RegisterType((*ClientRead)(nil), "ClientRead")
 	proto.RegisterType((*ReplicaRead)(nil), "ReplicaRead")
+	proto.RegisterType((*ScanRequest)(nil), "ScanRequest")
+	proto.RegisterType((*KeyspaceDefinition)(nil), "KeyspaceDefinition")
+	proto.RegisterMapType((map[string]uint32)(nil), "KeyspaceDefinition.DatacenterReplicationEntry")
 	proto.RegisterType((*ClientPut)(nil), "ClientPut")
 	proto.RegisterType((*ReplicaPut)(nil), "ReplicaPut")
+	proto.RegisterType((*Hint)(nil), "Hint")
+	proto.RegisterType((*TokenRange)(nil), "TokenRange")
+	proto.RegisterType((*MerkleTreeRequest)(nil), "MerkleTreeRequest")
+	proto.RegisterType((*MerkleTree)(nil), "MerkleTree")
+	proto.RegisterType((*RepairRowsRequest)(nil), "RepairRowsRequest")
+	proto.RegisterType((*RepairRows)(nil), "RepairRows")
+	proto.RegisterType((*RepairRequest)(nil), "RepairRequest")
+	proto.RegisterType((*EndpointState)(nil), "EndpointState")
+	proto.RegisterType((*Gossip)(nil), "Gossip")
+	proto.RegisterType((*DecommissionRequest)(nil), "DecommissionRequest")
+	proto.RegisterType((*SchemaRequest)(nil), "SchemaRequest")
+	proto.RegisterType((*Schema)(nil), "Schema")
 	proto.RegisterType((*InputRequest)(nil), "InputRequest")
 }
 
 func init() { proto.RegisterFile("cassandra.proto", fileDescriptor_32c4df2e0eaa2354) }
 
 var fileDescriptor_32c4df2e0eaa2354 = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x51, 0x6b, 0xd4, 0x40,
-	0x10, 0xc7, 0x2f, 0x97, 0xde, 0xb5, 0x99, 0xbd, 0xb6, 0xe7, 0x2a, 0x1a, 0x2a, 0xd2, 0x10, 0x04,
-	0x0f, 0x84, 0x2d, 0x46, 0x85, 0x0a, 0x82, 0x68, 0x11, 0xae, 0x0f, 0xb5, 0x75, 0xb5, 0xcf, 0xc7,
-	0x36, 0xb7, 0x1e, 0x8b, 0xb9, 0x4d, 0xdc, 0xdd, 0x14, 0xfa, 0xe0, 0xc7, 0xf0, 0x23, 0xf8, 0xe6,
-	0xa7, 0xf0, 0x93, 0xc9, 0x6e, 0x92, 0x4b, 0xce, 0x56, 0x50, 0xf0, 0x6d, 0x66, 0xf6, 0x3f, 0x3b,
-	0x33, 0xbf, 0x9d, 0x85, 0xdd, 0x94, 0x69, 0xcd, 0xe4, 0x5c, 0x31, 0x52, 0xa8, 0xdc, 0xe4, 0x7b,
-	0xfb, 0x8b, 0x3c, 0x5f, 0x64, 0xfc, 0xc0, 0x79, 0x17, 0xe5, 0xa7, 0x03, 0x23, 0x96, 0x5c, 0x1b,
-	0xb6, 0x2c, 0x2a, 0x41, 0xfc, 0xcd, 0x03, 0x7c, 0x2c, 0x85, 0xa1, 0xbc, 0xc8, 0x44, 0xca, 0x8e,
-	0xb2, 0x52, 0x1b, 0xae, 0xf0, 0x4b, 0x40, 0x2c, 0xcb, 0x66, 0xaa, 0x8a, 0x86, 0x5e, 0xe4, 0x4f,
-	0x50, 0x72, 0x9f, 0x5c, 0x57, 0x92, 0xda, 0xa5, 0xc0, 0xb2, 0xac, 0xb6, 0xf7, 0x5e, 0xc3, 0x66,
-	0x6d, 0x62, 0x0c, 0x1b, 0x92, 0x2d, 0x79, 0xe8, 0x45, 0xde, 0x24, 0xa0, 0xce, 0xc6, 0x3b, 0xd0,
-	0x17, 0x45, 0xd8, 0x77, 0x91, 0xbe, 0x28, 0xac, 0xa6, 0xc8, 0x95, 0x09, 0xfd, 0x4a, 0x63, 0xed,
-	0xf8, 0x7b, 0x1f, 0xc6, 0x94, 0x7f, 0x29, 0xb9, 0x36, 0x67, 0x4c, 0xb1, 0x25, 0xb7, 0x5d, 0x3d,
-	0x84, 0xed, 0x5c, 0x89, 0x85, 0x90, 0x74, 0xd5, 0x97, 0xcd, 0x58, 0x0f, 0xe2, 0x31, 0xf8, 0x9f,
-	0xf9, 0x95, 0xbb, 0x7f, 0x9b, 0x5a, 0x13, 0xdf, 0x81, 0xc1, 0x25, 0xcb, 0x4a, 0x5e, 0x57, 0xa8,
-	0x1c, 0xfc, 0x0a, 0x50, 0x9a, 0x4b, 0x2d, 0xb4, 0xe1, 0x32, 0xbd, 0x0a, 0x37, 0x22, 0x6f, 0xb2,
-	0x93, 0x3c, 0x20, 0xbf, 0x57, 0x25, 0x47, 0xad, 0x88, 0x76, 0x33, 0xf0, 0x21, 0x04, 0x2b, 0x9c,
-	0xe1, 0x20, 0xf2, 0x26, 0x28, 0xd9, 0x23, 0x15, 0x70, 0xd2, 0x00, 0x27, 0x1f, 0x1b, 0x05, 0x6d,
-	0xc5, 0x76, 0x10, 0xeb, 0x1c, 0xcb, 0x0f, 0x3c, 0xcd, 0xe5, 0x5c, 0x87, 0xc3, 0xc8, 0x9b, 0xf8,
-	0x74, 0x3d, 0x18, 0xc7, 0x80, 0x3a, 0xb5, 0xf1, 0x26, 0xf8, 0xa7, 0xef, 0xde, 0x8e, 0x7b, 0x18,
-	0x60, 0xf8, 0xfe, 0xfc, 0x94, 0x9e, 0x9f, 0x8c, 0xbd, 0xf8, 0x87, 0x07, 0x5b, 0x94, 0xeb, 0x22,
-	0x97, 0x9a, 0xff, 0x67, 0x3e, 0x21, 0x6c, 0x32, 0xa5, 0xc4, 0x25, 0xcb, 0x1c, 0x1b, 0x9f, 0x36,
-	0x2e, 0xbe, 0x0b, 0x43, 0x6d, 0x98, 0x29, 0xb5, 0x9b, 0x7a, 0x8b, 0xd6, 0x1e, 0x8e, 0x00, 0x29,
-	0xae, 0x8b, 0x13, 0xae, 0x35, 0x5b, 0x70, 0x37, 0x54, 0x40, 0xbb, 0xa1, 0xf8, 0x2b, 0xc0, 0x51,
-	0x26, 0xb8, 0x34, 0x94, 0xb3, 0x79, 0xd3, 0x89, 0xd7, 0x76, 0xf2, 0x62, 0xfd, 0x4d, 0xfa, 0xee,
-	0x4d, 0xee, 0x91, 0x36, 0xe7, 0x8f, 0xaf, 0xf1, 0x57, 0xb4, 0xf6, 0x01, 0x35, 0xfb, 0x7a, 0x63,
-	0xfd, 0xf8, 0x19, 0x04, 0x55, 0xad, 0xb3, 0xd2, 0xe0, 0x47, 0x30, 0x10, 0xb2, 0x28, 0x8d, 0x13,
-	0xa0, 0xe4, 0xd6, 0xb5, 0xd5, 0xa0, 0xd5, 0x79, 0xfc, 0x1c, 0xa0, 0xbe, 0xf6, 0x9f, 0xd2, 0x7e,
-	0xf6, 0x61, 0x74, 0x6c, 0xad, 0x5a, 0x80, 0x0f, 0x61, 0x24, 0xa4, 0x30, 0x9d, 0x6f, 0x67, 0x2f,
-	0xb8, 0x7d, 0xc3, 0xb7, 0x9b, 0xf6, 0x28, 0x12, 0x6d, 0x14, 0x13, 0x40, 0xa9, 0xeb, 0x7b, 0xa6,
-	0x38, 0x9b, 0x3b, 0x6e, 0x28, 0x41, 0x1d, 0x6e, 0xd3, 0x1e, 0x85, 0xb4, 0x25, 0xff, 0x04, 0x46,
-	0x75, 0x91, 0x2a, 0xc1, 0x77, 0x09, 0x23, 0xd2, 0xa1, 0x63, 0x4b, 0xa8, 0x0e, 0xac, 0xc7, 0x50,
-	0x5f, 0x30, 0xb3, 0xb3, 0x6d, 0xb8, 0x04, 0x20, 0x2b, 0x5a, 0xd3, 0x1e, 0x0d, 0xd2, 0x15, 0x3a,
-	0x02, 0x4d, 0xae, 0x53, 0x0f, 0xea, 0x7e, 0x5a, 0x4a, 0xb6, 0x1f, 0xd5, 0x65, 0xb6, 0xa5, 0xea,
-	0x2d, 0x76, 0x6b, 0x83, 0x92, 0x80, 0x34, 0x6b, 0x3d, 0xed, 0xd1, 0xd5, 0xe1, 0x9b, 0x5d, 0xd8,
-	0x76, 0xf0, 0x66, 0xaa, 0x62, 0x76, 0x31, 0x74, 0x3f, 0xed, 0xe9, 0xaf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xe6, 0x64, 0xaa, 0xfd, 0xfb, 0x04, 0x00, 0x00,
+	// 1568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xc9, 0x6e, 0xdb, 0x46,
+	0x1f, 0x17, 0x45, 0xad, 0x7f, 0x4a, 0xb2, 0x3c, 0xce, 0x42, 0x38, 0xc9, 0xf7, 0x39, 0x6c, 0x92,
+	0x1a, 0x48, 0xc3, 0x24, 0x4a, 0x8a, 0xba, 0x0b, 0x50, 0xb8, 0xb6, 0x12, 0xb9, 0xf1, 0x92, 0x8e,
+	0x9d, 0x16, 0x3d, 0x14, 0xc4, 0x84, 0x1c, 0x4b, 0x84, 0x25, 0x52, 0x1d, 0x8e, 0x1c, 0xf8, 0xd6,
+	0x73, 0x8f, 0x45, 0x5f, 0xa4, 0x6f, 0xd3, 0x1e, 0x7a, 0xee, 0xad, 0x40, 0xdf, 0xa0, 0x98, 0xe1,
+	0x50, 0x1c, 0x2d, 0x49, 0x50, 0xb4, 0xb9, 0xf1, 0xbf, 0xaf, 0xf3, 0x9b, 0x21, 0xac, 0xf8, 0x24,
+	0x49, 0x48, 0x14, 0x30, 0xe2, 0x8e, 0x59, 0xcc, 0xe3, 0xf5, 0xff, 0xf7, 0xe3, 0xb8, 0x3f, 0xa4,
+	0xf7, 0x25, 0xf5, 0x72, 0x72, 0x7a, 0x9f, 0x87, 0x23, 0x9a, 0x70, 0x32, 0x1a, 0xa7, 0x0a, 0xce,
+	0x1f, 0x06, 0xa0, 0xbd, 0x28, 0xe4, 0x98, 0x8e, 0x87, 0xa1, 0x4f, 0x76, 0x86, 0x93, 0x84, 0x53,
+	0x86, 0x3e, 0x03, 0x8b, 0x0c, 0x87, 0x1e, 0x4b, 0xb9, 0xb6, 0xb1, 0x61, 0x6e, 0x5a, 0x9d, 0x6b,
+	0xee, 0xa2, 0xa6, 0xab, 0x48, 0x0c, 0x64, 0x38, 0x54, 0xdf, 0xeb, 0x3f, 0x19, 0x50, 0x55, 0xdf,
+	0x08, 0x41, 0x29, 0x22, 0x23, 0x6a, 0x1b, 0x1b, 0xc6, 0x66, 0x1d, 0xcb, 0x6f, 0xd4, 0x82, 0x62,
+	0x38, 0xb6, 0x8b, 0x92, 0x53, 0x0c, 0xc7, 0x42, 0x67, 0x1c, 0x33, 0x6e, 0x9b, 0xa9, 0x8e, 0xf8,
+	0x46, 0xd7, 0xa0, 0xde, 0x67, 0x63, 0xdf, 0x93, 0x82, 0x92, 0x14, 0xd4, 0x04, 0xe3, 0xb9, 0x10,
+	0xfe, 0x0f, 0x20, 0x20, 0x9c, 0xf8, 0x34, 0xe2, 0x94, 0xd9, 0x65, 0x29, 0xd5, 0x38, 0xc2, 0x21,
+	0x23, 0xfe, 0x99, 0x5d, 0x49, 0x1d, 0x8a, 0x6f, 0xe7, 0x47, 0x13, 0xda, 0x98, 0x7e, 0x3f, 0xa1,
+	0x09, 0x7f, 0x4e, 0x18, 0x19, 0x51, 0xa1, 0x78, 0x0b, 0x9a, 0x31, 0x0b, 0xfb, 0x61, 0x84, 0xa7,
+	0x95, 0x0a, 0x8b, 0x59, 0x26, 0x6a, 0x83, 0x79, 0x46, 0x2f, 0x64, 0xc2, 0x0d, 0x2c, 0x3e, 0xd1,
+	0x25, 0x28, 0x9f, 0x93, 0xe1, 0x84, 0xaa, 0x94, 0x53, 0x02, 0x7d, 0x0e, 0x96, 0x1f, 0x47, 0x49,
+	0x98, 0x70, 0x1a, 0xf9, 0x17, 0x32, 0xeb, 0x56, 0xe7, 0x86, 0x3b, 0x1f, 0xd5, 0xdd, 0xc9, 0x95,
+	0xb0, 0x6e, 0x81, 0xb6, 0xa0, 0x3e, 0x1d, 0x90, 0x2c, 0xcb, 0xea, 0xac, 0xbb, 0xe9, 0x08, 0xdd,
+	0x6c, 0x84, 0xee, 0x49, 0xa6, 0x81, 0x73, 0x65, 0x51, 0x88, 0x20, 0xf6, 0xa2, 0x63, 0xea, 0xc7,
+	0x51, 0x90, 0xc8, 0xd2, 0x4d, 0x3c, 0xcb, 0x44, 0xeb, 0x50, 0x3b, 0xa3, 0x17, 0xc9, 0x98, 0xf8,
+	0xd4, 0xae, 0xa6, 0x3d, 0xcd, 0x68, 0xe7, 0x0c, 0x2c, 0x2d, 0x2f, 0x54, 0x05, 0xf3, 0xe8, 0xb0,
+	0xdb, 0x2e, 0x20, 0x80, 0xca, 0x57, 0x2f, 0x8e, 0xf0, 0x8b, 0x83, 0xb6, 0x21, 0x98, 0x27, 0xdf,
+	0x1c, 0xb5, 0x8b, 0xa8, 0x0e, 0xe5, 0x93, 0x1e, 0xee, 0x76, 0xdb, 0xa6, 0xe0, 0x6d, 0xef, 0xef,
+	0xb7, 0x4b, 0xf2, 0xe3, 0xf0, 0xdb, 0x76, 0x19, 0xb5, 0xa1, 0xb1, 0x7f, 0xb4, 0xb3, 0xbd, 0xef,
+	0x29, 0xbb, 0x0a, 0x5a, 0x01, 0xab, 0xbb, 0xbd, 0xd3, 0xcb, 0x18, 0x55, 0xe7, 0x57, 0x03, 0x6a,
+	0x98, 0x26, 0xe3, 0x38, 0x4a, 0xe8, 0x7f, 0x3c, 0x04, 0x1b, 0xaa, 0x84, 0xb1, 0xf0, 0x9c, 0x0c,
+	0xe5, 0x00, 0x4c, 0x9c, 0x91, 0xe8, 0x0a, 0x54, 0x12, 0x4e, 0xf8, 0x24, 0x91, 0xad, 0xad, 0x61,
+	0x45, 0xa1, 0x0d, 0xb0, 0x18, 0x4d, 0xc6, 0x07, 0x34, 0x49, 0x48, 0x9f, 0xaa, 0xa5, 0xd1, 0x59,
+	0x62, 0x9f, 0xfc, 0x38, 0x48, 0x7b, 0xd6, 0xc4, 0xf2, 0x5b, 0x78, 0x0b, 0xc2, 0x3e, 0x4d, 0xb8,
+	0x5d, 0x93, 0x29, 0x29, 0xca, 0xf9, 0xcd, 0x00, 0xd8, 0x19, 0x86, 0x34, 0xe2, 0x98, 0x92, 0x20,
+	0x4b, 0xdb, 0xc8, 0xd3, 0xfe, 0x78, 0x76, 0x4b, 0x8a, 0x72, 0x4b, 0xae, 0xba, 0xb9, 0xcd, 0xeb,
+	0xf7, 0x43, 0x9f, 0x9f, 0x39, 0x37, 0xbf, 0x97, 0xff, 0x76, 0x7e, 0xf3, 0x63, 0x2b, 0xcf, 0x8f,
+	0xad, 0xe2, 0x1c, 0x83, 0x95, 0x9d, 0xf7, 0xe5, 0xb5, 0xe9, 0x09, 0x16, 0x67, 0x13, 0xd4, 0x1a,
+	0x66, 0xa6, 0xed, 0x57, 0x0d, 0xf3, 0xc0, 0x3a, 0xf6, 0x49, 0xa4, 0x4e, 0x89, 0x38, 0xf8, 0x09,
+	0x27, 0x8c, 0x7b, 0xb9, 0xeb, 0x9a, 0x64, 0x3c, 0xa3, 0x17, 0xe8, 0x2a, 0x54, 0x69, 0x14, 0x78,
+	0xf9, 0x22, 0x54, 0x68, 0x14, 0x3c, 0xa3, 0x6f, 0xee, 0xcc, 0xef, 0x45, 0x40, 0xcf, 0x14, 0xb1,
+	0x4b, 0x4f, 0xc3, 0x28, 0xe4, 0x61, 0x1c, 0x2d, 0x45, 0xa6, 0x7b, 0x80, 0x14, 0xe6, 0x09, 0x15,
+	0xef, 0x94, 0xf8, 0x3c, 0x66, 0x32, 0x54, 0x13, 0xaf, 0x6a, 0x92, 0x27, 0x52, 0x80, 0x3e, 0x10,
+	0xea, 0x24, 0x10, 0x38, 0x49, 0x42, 0xe6, 0xf9, 0x03, 0x12, 0xa9, 0xf8, 0x06, 0x6e, 0x0b, 0x09,
+	0x96, 0x82, 0x1d, 0xc9, 0x47, 0xb7, 0xa1, 0x35, 0x08, 0x23, 0x4e, 0x03, 0x6f, 0x40, 0xa2, 0x20,
+	0x3e, 0x3d, 0x95, 0x0b, 0x5a, 0xc3, 0xcd, 0x94, 0xdb, 0x4b, 0x99, 0x88, 0xc2, 0x95, 0x1c, 0xca,
+	0x3c, 0x2d, 0xa8, 0x5d, 0x96, 0x30, 0xec, 0xba, 0x8b, 0xc5, 0xb8, 0xbb, 0x53, 0x0b, 0x9c, 0x1b,
+	0x74, 0x23, 0xce, 0x2e, 0xf0, 0xe5, 0x60, 0x99, 0x6c, 0xbd, 0x07, 0xeb, 0xaf, 0x37, 0xd2, 0x47,
+	0x5b, 0x9f, 0x3b, 0x6d, 0x69, 0x37, 0x52, 0xe2, 0x93, 0xe2, 0x96, 0xe1, 0x3c, 0x86, 0x7a, 0xba,
+	0xbc, 0xcf, 0x27, 0x1c, 0xbd, 0x0f, 0xe5, 0x30, 0x1a, 0x4f, 0xb8, 0x34, 0xb5, 0x3a, 0xab, 0x0b,
+	0xe8, 0x87, 0x53, 0xb9, 0xf3, 0x21, 0x80, 0x8a, 0xfa, 0x8f, 0xcc, 0x7e, 0x31, 0xa0, 0xd4, 0x0b,
+	0x23, 0x2e, 0xba, 0xc9, 0x09, 0xeb, 0x53, 0xae, 0xdd, 0x52, 0x12, 0x36, 0x52, 0x6e, 0x06, 0x1b,
+	0x6f, 0xda, 0x48, 0x55, 0xa4, 0xb9, 0x04, 0x52, 0x4a, 0x3a, 0xa4, 0x2c, 0x80, 0x6b, 0x79, 0x19,
+	0xb8, 0xda, 0x50, 0xf5, 0x19, 0x25, 0x9c, 0x06, 0x0a, 0x7c, 0x33, 0xd2, 0x79, 0x0c, 0x70, 0x12,
+	0x9f, 0xd1, 0x08, 0x93, 0xa8, 0x4f, 0x45, 0x0c, 0xb9, 0xcf, 0x32, 0x5f, 0x13, 0xa7, 0x84, 0xc8,
+	0x85, 0x46, 0x81, 0x4c, 0xd1, 0xc4, 0xe2, 0xd3, 0x19, 0xc0, 0xea, 0x01, 0x65, 0x67, 0x43, 0x7a,
+	0xc2, 0x28, 0xcd, 0x4e, 0x87, 0x5e, 0x8e, 0x31, 0x57, 0xce, 0x4d, 0x28, 0x33, 0x11, 0x41, 0x3a,
+	0xb1, 0x3a, 0x96, 0x9b, 0x07, 0xc5, 0x65, 0x96, 0xc5, 0x0e, 0xe8, 0x98, 0x0f, 0x64, 0xcd, 0x4d,
+	0x9c, 0x12, 0xce, 0x77, 0x00, 0x79, 0xa4, 0xdc, 0x8d, 0xf1, 0x76, 0x37, 0x45, 0xcd, 0x8d, 0x38,
+	0xe0, 0x03, 0x92, 0x0c, 0x68, 0x62, 0x9b, 0x1b, 0xa6, 0x38, 0x9b, 0x29, 0xe5, 0xfc, 0x60, 0xc0,
+	0x6a, 0x7a, 0x10, 0x70, 0xfc, 0x2a, 0x79, 0x97, 0x95, 0x88, 0x14, 0x86, 0x94, 0x9c, 0xd3, 0xc4,
+	0x2e, 0x6d, 0x98, 0x9b, 0x4d, 0xac, 0x28, 0xe7, 0x11, 0x40, 0x9e, 0x01, 0xba, 0x0d, 0x25, 0x16,
+	0xbf, 0x4a, 0xd4, 0xb3, 0x66, 0xc9, 0xae, 0x49, 0xb1, 0x73, 0x17, 0x9a, 0xca, 0xe8, 0xed, 0x29,
+	0x3b, 0x7f, 0x15, 0xa1, 0xd9, 0x8d, 0x82, 0x71, 0x1c, 0x46, 0xfc, 0x98, 0x13, 0x4e, 0xdf, 0xd9,
+	0xcb, 0xa7, 0x4f, 0x23, 0xca, 0x32, 0x40, 0x10, 0xdb, 0xa2, 0x71, 0xc4, 0x12, 0x9e, 0x53, 0x96,
+	0x08, 0xa1, 0x58, 0xc2, 0x12, 0xce, 0x48, 0x74, 0x6f, 0x7a, 0xfb, 0x55, 0xe5, 0x8d, 0x73, 0xd9,
+	0x9d, 0x49, 0xd7, 0x3d, 0x96, 0xc2, 0xe9, 0xa5, 0x78, 0x05, 0x2a, 0x5c, 0x34, 0x3d, 0xb1, 0x6b,
+	0x1b, 0xe6, 0xa6, 0x89, 0x15, 0x85, 0xae, 0x43, 0x5d, 0x9c, 0x37, 0xe2, 0x87, 0x51, 0xdf, 0xae,
+	0xcb, 0xec, 0x72, 0xc6, 0xdc, 0xc3, 0x0c, 0x5e, 0xfb, 0x30, 0xb3, 0xb4, 0x87, 0xd9, 0x16, 0x54,
+	0xd2, 0xd8, 0xe2, 0xaa, 0x3a, 0x3c, 0xc2, 0x07, 0xdb, 0xfb, 0xed, 0x02, 0xb2, 0xa0, 0xfa, 0xe5,
+	0xd1, 0xde, 0xe1, 0xde, 0xe1, 0xd3, 0xb6, 0x21, 0x88, 0xfd, 0xee, 0xf6, 0xd7, 0x82, 0x28, 0xa2,
+	0x1a, 0x94, 0xf6, 0xbb, 0x4f, 0x4e, 0xda, 0xa6, 0xf3, 0x00, 0x2a, 0x4f, 0xe3, 0x24, 0x09, 0xc7,
+	0xe8, 0x4e, 0x5a, 0x1c, 0xcd, 0x66, 0xda, 0x9a, 0x2d, 0x0e, 0x2b, 0xa9, 0x73, 0x19, 0xd6, 0x76,
+	0xa9, 0x1f, 0x8f, 0x46, 0x61, 0x22, 0x9a, 0xa2, 0x06, 0xeb, 0xac, 0x40, 0xf3, 0xd8, 0x1f, 0xd0,
+	0x11, 0xc9, 0x18, 0x9f, 0x42, 0x25, 0x65, 0xa0, 0x87, 0x50, 0xcf, 0x66, 0x9c, 0x39, 0x5f, 0x5b,
+	0x02, 0xc0, 0x38, 0xd7, 0x72, 0x7e, 0xae, 0x40, 0x63, 0x4f, 0x80, 0x55, 0xb6, 0x37, 0x5b, 0xd0,
+	0x10, 0x6a, 0x33, 0x40, 0x25, 0xdc, 0x2c, 0x3e, 0xa7, 0x7b, 0x05, 0x6c, 0x85, 0x39, 0x17, 0xb9,
+	0x60, 0xf9, 0x12, 0x5a, 0x3d, 0x71, 0x9b, 0x4c, 0x8f, 0x43, 0xfe, 0x56, 0xe8, 0x15, 0x30, 0xf8,
+	0x53, 0x0a, 0x3d, 0x84, 0x86, 0x0a, 0x92, 0x1a, 0x98, 0xd2, 0xa0, 0xe1, 0x6a, 0xb7, 0xb6, 0x08,
+	0xc1, 0x72, 0x12, 0xdd, 0x05, 0xe5, 0xc0, 0x1b, 0x4f, 0xd2, 0x7d, 0xb3, 0x3a, 0xe0, 0x4e, 0x01,
+	0xbd, 0x57, 0xc0, 0x75, 0x3f, 0x23, 0x44, 0x3e, 0x99, 0x7f, 0xa1, 0x5d, 0x56, 0xf9, 0xe4, 0x40,
+	0x2e, 0xf2, 0x61, 0x3a, 0xac, 0xd7, 0x98, 0x7a, 0xe6, 0xc9, 0x7d, 0xb4, 0x3a, 0x75, 0x37, 0x7b,
+	0xf7, 0xf5, 0x0a, 0x78, 0x2a, 0x44, 0x4f, 0x60, 0x2d, 0x6b, 0xa0, 0x17, 0x4c, 0xbb, 0x6a, 0xd7,
+	0x54, 0xa7, 0x16, 0x1b, 0xde, 0x2b, 0x60, 0x74, 0xb6, 0xc0, 0x45, 0xbb, 0xb0, 0x36, 0x92, 0x50,
+	0xe6, 0x71, 0x46, 0xa9, 0xc7, 0xd2, 0x09, 0xc8, 0x45, 0xb5, 0x3a, 0xc8, 0x5d, 0x00, 0xd4, 0x5e,
+	0x01, 0xaf, 0x8e, 0xe6, 0x99, 0xa2, 0x4c, 0xcd, 0x8b, 0x0d, 0xaa, 0xcc, 0xdc, 0x5a, 0x94, 0x99,
+	0x9b, 0x89, 0xa8, 0xea, 0x09, 0x20, 0x80, 0x63, 0x1a, 0xd5, 0x52, 0x51, 0x17, 0xc0, 0x4f, 0x44,
+	0x65, 0xf3, 0x4c, 0xd5, 0xdc, 0xcc, 0x8b, 0xdd, 0xc8, 0x9b, 0xab, 0x14, 0x55, 0x73, 0x15, 0x85,
+	0x6e, 0x42, 0xa5, 0x2f, 0xd7, 0xdf, 0x6e, 0x4a, 0xd5, 0xaa, 0x9b, 0x9e, 0x86, 0x5e, 0x01, 0x2b,
+	0x01, 0xfa, 0x08, 0x5a, 0x89, 0xdc, 0xe3, 0x69, 0x4e, 0x2d, 0xa9, 0xda, 0x72, 0x67, 0xf6, 0xbd,
+	0x57, 0xc0, 0xcd, 0x44, 0x67, 0x08, 0xdf, 0x29, 0xc3, 0x5e, 0x51, 0xbe, 0x53, 0x03, 0xe1, 0x3b,
+	0x15, 0xa0, 0x1b, 0x00, 0xca, 0xa9, 0x17, 0x06, 0x12, 0x54, 0x4a, 0xb8, 0xae, 0x38, 0x7b, 0xc1,
+	0x17, 0x2b, 0xd0, 0x94, 0x37, 0x76, 0x16, 0xb9, 0xf3, 0x67, 0x31, 0xff, 0x2b, 0xbc, 0x07, 0x96,
+	0x58, 0xfe, 0xec, 0x77, 0x73, 0xd9, 0x51, 0x58, 0xcf, 0x37, 0x05, 0x3d, 0x80, 0xd6, 0x8e, 0xbc,
+	0x4b, 0xb3, 0x3d, 0x40, 0xcb, 0x56, 0x42, 0xb7, 0xb8, 0x0e, 0xa6, 0xd8, 0x3f, 0x6d, 0x91, 0x75,
+	0xe9, 0x0d, 0x30, 0x9f, 0x52, 0x8e, 0xf4, 0x83, 0xa4, 0x8b, 0x6f, 0xcd, 0x3c, 0x4d, 0xf4, 0xf5,
+	0xd6, 0xb5, 0xee, 0xcc, 0x3e, 0x86, 0x67, 0x0e, 0x99, 0xae, 0xf7, 0x1e, 0x54, 0xd2, 0x11, 0xa2,
+	0x96, 0x3b, 0x73, 0x9f, 0xe8, 0x4a, 0xf7, 0xa1, 0xa1, 0x03, 0x13, 0xba, 0xe4, 0x2e, 0xc1, 0x29,
+	0xdd, 0xe0, 0x26, 0x94, 0xc4, 0xab, 0x19, 0x35, 0x5c, 0xed, 0xf1, 0xac, 0x29, 0x3c, 0x30, 0x5e,
+	0x56, 0xe4, 0x2f, 0xe3, 0xa3, 0xbf, 0x07, 0x00, 0x6d, 0xa9, 0xad, 0x30, 0x16, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ReplicaClient is the client API for Replica service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReplicaClient interface {
+	InitCluster(ctx context.Context, in *InitReplicaCluster, opts ...grpc.CallOption) (*Response, error)
+	CreateKeyspace(ctx context.Context, in *KeyspaceDefinition, opts ...grpc.CallOption) (*Response, error)
+	Put(ctx context.Context, in *ClientPut, opts ...grpc.CallOption) (*Response, error)
+	Get(ctx context.Context, in *ClientRead, opts ...grpc.CallOption) (*Response, error)
+	ReplicaPut(ctx context.Context, in *ReplicaPut, opts ...grpc.CallOption) (*Response, error)
+	ReplicaRead(ctx context.Context, in *ReplicaRead, opts ...grpc.CallOption) (*Response, error)
+	Repair(ctx context.Context, in *RepairRequest, opts ...grpc.CallOption) (*Response, error)
+	Decommission(ctx context.Context, in *DecommissionRequest, opts ...grpc.CallOption) (*Response, error)
+	Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (Replica_ScanClient, error)
+}
+
+type replicaClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReplicaClient(cc grpc.ClientConnInterface) ReplicaClient {
+	return &replicaClient{cc}
+}
+
+func (c *replicaClient) InitCluster(ctx context.Context, in *InitReplicaCluster, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/Replica/InitCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicaClient) CreateKeyspace(ctx context.Context, in *KeyspaceDefinition, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/Replica/CreateKeyspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicaClient) Put(ctx context.Context, in *ClientPut, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/Replica/Put", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicaClient) Get(ctx context.Context, in *ClientRead, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/Replica/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicaClient) ReplicaPut(ctx context.Context, in *ReplicaPut, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/Replica/ReplicaPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicaClient) ReplicaRead(ctx context.Context, in *ReplicaRead, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/Replica/ReplicaRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicaClient) Repair(ctx context.Context, in *RepairRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/Replica/Repair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicaClient) Decommission(ctx context.Context, in *DecommissionRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/Replica/Decommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicaClient) Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (Replica_ScanClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Replica_serviceDesc.Streams[0], "/Replica/Scan", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &replicaScanClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Replica_ScanClient interface {
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type replicaScanClient struct {
+	grpc.ClientStream
+}
+
+func (x *replicaScanClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ReplicaServer is the server API for Replica service.
+type ReplicaServer interface {
+	InitCluster(context.Context, *InitReplicaCluster) (*Response, error)
+	CreateKeyspace(context.Context, *KeyspaceDefinition) (*Response, error)
+	Put(context.Context, *ClientPut) (*Response, error)
+	Get(context.Context, *ClientRead) (*Response, error)
+	ReplicaPut(context.Context, *ReplicaPut) (*Response, error)
+	ReplicaRead(context.Context, *ReplicaRead) (*Response, error)
+	Repair(context.Context, *RepairRequest) (*Response, error)
+	Decommission(context.Context, *DecommissionRequest) (*Response, error)
+	Scan(*ScanRequest, Replica_ScanServer) error
+}
+
+// UnimplementedReplicaServer can be embedded to have forward compatible implementations.
+type UnimplementedReplicaServer struct {
+}
+
+func (*UnimplementedReplicaServer) InitCluster(ctx context.Context, req *InitReplicaCluster) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitCluster not implemented")
+}
+func (*UnimplementedReplicaServer) CreateKeyspace(ctx context.Context, req *KeyspaceDefinition) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKeyspace not implemented")
+}
+func (*UnimplementedReplicaServer) Put(ctx context.Context, req *ClientPut) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+}
+func (*UnimplementedReplicaServer) Get(ctx context.Context, req *ClientRead) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedReplicaServer) ReplicaPut(ctx context.Context, req *ReplicaPut) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaPut not implemented")
+}
+func (*UnimplementedReplicaServer) ReplicaRead(ctx context.Context, req *ReplicaRead) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaRead not implemented")
+}
+func (*UnimplementedReplicaServer) Repair(ctx context.Context, req *RepairRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Repair not implemented")
+}
+func (*UnimplementedReplicaServer) Decommission(ctx context.Context, req *DecommissionRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decommission not implemented")
+}
+func (*UnimplementedReplicaServer) Scan(req *ScanRequest, srv Replica_ScanServer) error {
+	return status.Errorf(codes.Unimplemented, "method Scan not implemented")
+}
+
+func RegisterReplicaServer(s *grpc.Server, srv ReplicaServer) {
+	s.RegisterService(&_Replica_serviceDesc, srv)
+}
+
+func _Replica_InitCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitReplicaCluster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicaServer).InitCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Replica/InitCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicaServer).InitCluster(ctx, req.(*InitReplicaCluster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Replica_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyspaceDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicaServer).CreateKeyspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Replica/CreateKeyspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicaServer).CreateKeyspace(ctx, req.(*KeyspaceDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Replica_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientPut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicaServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Replica/Put",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicaServer).Put(ctx, req.(*ClientPut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Replica_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicaServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Replica/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicaServer).Get(ctx, req.(*ClientRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Replica_ReplicaPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicaPut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicaServer).ReplicaPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Replica/ReplicaPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicaServer).ReplicaPut(ctx, req.(*ReplicaPut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Replica_ReplicaRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicaRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicaServer).ReplicaRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Replica/ReplicaRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicaServer).ReplicaRead(ctx, req.(*ReplicaRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Replica_Repair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicaServer).Repair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Replica/Repair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicaServer).Repair(ctx, req.(*RepairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Replica_Decommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicaServer).Decommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Replica/Decommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicaServer).Decommission(ctx, req.(*DecommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Replica_Scan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReplicaServer).Scan(m, &replicaScanServer{stream})
+}
+
+type Replica_ScanServer interface {
+	Send(*Response) error
+	grpc.ServerStream
+}
+
+type replicaScanServer struct {
+	grpc.ServerStream
+}
+
+func (x *replicaScanServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Replica_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Replica",
+	HandlerType: (*ReplicaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InitCluster",
+			Handler:    _Replica_InitCluster_Handler,
+		},
+		{
+			MethodName: "CreateKeyspace",
+			Handler:    _Replica_CreateKeyspace_Handler,
+		},
+		{
+			MethodName: "Put",
+			Handler:    _Replica_Put_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Replica_Get_Handler,
+		},
+		{
+			MethodName: "ReplicaPut",
+			Handler:    _Replica_ReplicaPut_Handler,
+		},
+		{
+			MethodName: "ReplicaRead",
+			Handler:    _Replica_ReplicaRead_Handler,
+		},
+		{
+			MethodName: "Repair",
+			Handler:    _Replica_Repair_Handler,
+		},
+		{
+			MethodName: "Decommission",
+			Handler:    _Replica_Decommission_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Scan",
+			Handler:       _Replica_Scan_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cassandra.proto",
 }
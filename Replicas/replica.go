@@ -1,32 +1,67 @@
 package main
 
 import (
+	"../FailureDetector"
+	"../Gossip"
+	"../Hints"
 	"../IP_Address"
+	"../Merkle"
 	"../Protobuf"
+	"../Ring"
+	"../Storage"
+	"../Transport"
 	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"flag"
 	"fmt"
-	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"io"
 	"log"
+	"math"
+	"maps"
+	"math/rand"
 	"net"
 	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 //---------------------------------------------------------------------------//
 
 //Constants Declaration
-const maxBytes = 8192
 const yes = "1"
 const Client = "CLIENT"
 const separator = "@#"
 const consistencyQuorum = "QUORUM"
 const consistencyOne = "ONE"
+const consistencyTwo = "TWO"
+const consistencyThree = "THREE"
+const consistencyAll = "ALL"
+const consistencyAny = "ANY"
+const consistencyLocalQuorum = "LOCAL_QUORUM"
+const consistencyEachQuorum = "EACH_QUORUM"
+const storageMemory = "memory"
+const storageLSM = "lsm"
 const constOne = 1
+const connectionsPerReplica = 2
+const replicaRequestTimeout = 5 * time.Second
+const grpcPortOffset = 1 //Default gRPC Port = Replica Port + 1
+const defaultKeyspace = "default"
+const defaultReplicationFactor = 3
+const defaultReadRepairChance = 1.0
+const maxKeyspaceNameLength = 48
 
 //Replica Config Details
 type replica struct {
@@ -34,80 +69,268 @@ type replica struct {
 	IP         string
 	Port       string
 	TCPAddress *net.TCPAddr
+	GrpcPort   string
+	Datacenter string //Snitch: Where the Replica Runs
+	Rack       string
+	Pool       *Transport.Pool //Long-Lived Connections to this Replica
+}
+
+//Where a Handler Sends its Response - A Framed Connection or a gRPC Call
+type responder interface {
+	Send(msg *cassandra.InputRequest) error
 }
 
 var myConfig replica
-var myReplicaCluster = make(map[string]replica)
+var myReplicaCluster = clusterTable{replicas: make(map[string]replica)}
 var replicaNames = []string{}
 
+//Other Replicas in the Cluster - Gossip Can Add or Remove them While Requests are Served
+type clusterTable struct {
+	replicas map[string]replica
+	mtx      sync.RWMutex
+}
+
 //Flag to Identify If the Replica is Rebooting
 var isReplicaRebooting = "0" // "0"-NO ; "1"-YES
 
 //Key-Value Mapping
 type keyConfig struct {
-	MyValue          string
-	Arrived          int64
-	ReplicaAssigned1 string
-	ReplicaAssigned2 string
-	ReplicaAssigned3 string
+	MyValue string
+	Arrived int64
+}
+
+//Where a Replica Keeps its Rows. Keys are StorageKey Bytes. A Put or Delete Older than the Stored Version is
+//Ignored; Scan Visits [start, end) in Byte Order, Tombstones Included, Until visit Returns False (a Nil end
+//Means No Upper Bound). Engines are Safe for Concurrent Use
+type StorageEngine interface {
+	Get(key []byte) (Storage.Row, error)
+	Put(key []byte, row Storage.Row) error
+	Delete(key []byte, timestamp int64) error
+	Scan(start []byte, end []byte, visit func(key []byte, row Storage.Row) bool) error
+	Flush() error
+	Close() error
 }
 
+//The Replica's Rows - Every Read and Write Goes Through the Storage Engine
 type criticalSection struct {
-	KeyValues map[uint32]keyConfig
-	mtx       sync.Mutex
+	Engine StorageEngine
+	Log    *Storage.CommitLog //Memory Engine: the Storage Log it is Rebuilt from; Nil if the Engine Logs its Own Writes
 }
 
 var KeyValueConfig criticalSection
 
+//Storage Log Compaction
+var storageCompactionInterval = time.Minute
+var compactionThrottle *Storage.Throttle
+
+const storageCompactionMin = 1 << 20 //A Storage Log Smaller than this is Never Compacted
+const storageCompactionRatio = 2     //Compact Once the Log Grows to this Many Times its Compacted Size
+
+//Per-Keyspace Settings
+type keyspaceSettings struct {
+	ReplicationFactor int            //Replicas per Key - the Sum Over Datacenters for NetworkTopologyStrategy
+	Datacenters       map[string]int //NetworkTopologyStrategy: Replicas per Datacenter; Empty for SimpleStrategy
+	ReadRepairChance  float64        //Fraction of Reads Followed by Read Repair
+	HintedHandOff     bool           //Hint Writes Missed by an Owner
+}
+
+//Keyspaces - Each has its Own Replication Factor and Repair Settings
+type keyspaceTable struct {
+	Keyspaces map[string]keyspaceSettings
+	fileName  string
+	mtx       sync.Mutex
+}
+
+var KeyspaceConfig keyspaceTable
+
+//Token Ring - Keys are Hashed (Murmur3) onto the Ring to Find their Replicas
+var tokenRing *Ring.Ring
+
 //To Check the latest Key-Value Pair
 type latestVal struct {
-	Replica string
-	Key     uint32
-	Value   string
-	Arrived int64
+	Replica  string
+	Keyspace string
+	Key      []byte
+	Value    string
+	Arrived  int64
+	Digest   []byte
+	HasValue bool //False When Only the Digest is Known
+}
+
+//Outcome of One ReplicaPut Sent by the Coordinator
+type replicaAck struct {
+	ReplicaName string
+	Pending     bool //Sent to a Next Owner During a Ring Change - Does Not Count Toward the Consistency Level
+	Err         error
 }
 
-//Replica Initialized
-var replicaInitialized = false
+//Replicas Counted Toward a Consistency Level - Keyed by Datacenter for LOCAL_QUORUM and EACH_QUORUM,
+//Under the Single Key "" for Every Other Level
+type replicaQuorum struct {
+	Required map[string]int
+	Counted  map[string]int
+}
 
-//Hints Structure
-type hints struct {
+//Outcome of One ReplicaRead Sent by the Coordinator
+type replicaReadResult struct {
 	ReplicaName string
-	Key         uint32
-	Value       string
-	Arrived     int64
+	Response    *cassandra.Response
+	Err         error
+}
+
+//Replica Initialized - Set by the Client, the Config File or Gossip; Read by Every Request
+var replicaInitialized atomic.Bool
+var replicaInitializing atomic.Bool //An InitReplicaCluster Request is Being Applied
+
+//Durable Log for Hinted Hand-Off - One File per Target Replica
+var hintStore *Hints.Store
+
+//Hint Delivery Settings
+var hintWindow = 3 * time.Hour              //Hints Older than this are Dropped
+var hintDeliveryInterval = 10 * time.Second //How Often the Dispatcher Looks for Reachable Targets
+var hintDeliveryRate = 100                  //Hints per Second per Target
+
+//Targets Whose Hints are Being Delivered Right Now
+type hintDelivery struct {
+	active map[string]bool
+	mtx    sync.Mutex
+}
+
+var hintDeliveries = hintDelivery{active: make(map[string]bool)}
+
+//Anti-Entropy Repair Settings
+var repairInterval time.Duration      //Zero: Repair Only When Asked
+var repairDepth = Merkle.DefaultDepth //Merkle Tree Depth - 2^Depth Leaves per Token Range
+
+//Only One Repair Runs at a Time
+type repairSession struct {
+	running bool
+	mtx     sync.Mutex
+}
+
+var repairs repairSession
+
+//Gossip - Membership, Status and Tokens Spread Replica to Replica, Starting from the Seeds
+var gossipTable *Gossip.Table
+var gossipSeeds []*Transport.Pool
+var gossipInterval = time.Second
+
+const gossipRequestTimeout = 2 * time.Second
+
+//Liveness of Every Peer, Fed by Gossip Heartbeats - Requests Only Consult it
+var failureDetector *FailureDetector.Detector
+
+//Bootstrap - A Replica Joining a Running Cluster Streams the Ranges it Will Own Before it Serves
+var bootstrapping atomic.Bool //Cleared by the Bootstrap Goroutine, Read by Every Request
+var replaceReplica = "" //Dead Replica Whose Tokens a Bootstrapping Replica Takes Over
+
+const bootstrapRingDelay = 10 //Gossip Rounds Waited so Every Coordinator Knows this Replica is JOINING
+const streamDepth = 4         //Each Range is Streamed in 2^streamDepth Pieces
+
+//Ring Changes Under Way - Coordinators Also Send the Writes for a Moving Range to its Next Owners
+type pendingTable struct {
+	joining   map[string][]int64 //JOINING Replica -> the Tokens it Will Take
+	replacing map[string]string  //JOINING Replica -> the Dead Replica it Takes Over
+	leaving   map[string]bool
+	mtx       sync.Mutex
 }
 
-//Log for Hinted Hand-Off
-var hintCount = 0
-var hintedHandOff = make(map[int]hints)
+var pendingReplicas = pendingTable{joining: make(map[string][]int64), replacing: make(map[string]string), leaving: make(map[string]bool)}
 
-//Replica Modes
-var readRepairMode = false    //1=Read Repair
-var hintedHandOffMode = false //2=Hinted HandOff
+//A Replica Decommissions Only Once
+type decommissionSession struct {
+	started bool
+	mtx     sync.Mutex
+}
+
+var decommission decommissionSession
+
+//Replica Modes - Either or Both; Each Keyspace Can Narrow them Further
+var readRepairMode = false    //1=Read Repair, 3=Both
+var hintedHandOffMode = false //2=Hinted HandOff, 3=Both
 
 //---------------------------------------------------------------------------//
 
 func main() {
 
+	//Optional Settings - Must Precede the Positional Arguments
+	maxMessageSize := flag.Uint("max-message-size", Transport.DefaultMaxMessageSize, "Largest request/response frame in bytes")
+	vnodes := flag.Int("vnodes", Ring.DefaultVnodes, "Virtual nodes (tokens) per replica; must match on every replica")
+	grpcPort := flag.String("grpc-port", "", "Port for the gRPC API (default: replica port + 1)")
+	flag.DurationVar(&hintWindow, "hint-window", hintWindow, "Drop hints older than this")
+	flag.DurationVar(&hintDeliveryInterval, "hint-interval", hintDeliveryInterval, "How often to deliver hints to replicas that are reachable again")
+	flag.IntVar(&hintDeliveryRate, "hint-rate", hintDeliveryRate, "Hints delivered per second to one replica")
+	flag.DurationVar(&repairInterval, "repair-interval", repairInterval, "Run anti-entropy repair this often (0: only when asked)")
+	flag.IntVar(&repairDepth, "repair-depth", repairDepth, "Merkle tree depth used by repair; must match on every replica")
+	seeds := flag.String("seeds", "", "Comma-separated <ip>:<port> of seed replicas; the replica joins through gossip instead of the client")
+	broadcastAddress := flag.String("broadcast-address", "", "IP other replicas use to reach this one (default: public IP)")
+	flag.DurationVar(&gossipInterval, "gossip-interval", gossipInterval, "Time between gossip rounds")
+	phiThreshold := flag.Float64("phi-threshold", FailureDetector.DefaultThreshold, "Suspicion level (phi) at which a replica is considered DOWN")
+	bootstrap := flag.Bool("bootstrap", false, "Join a running cluster through the seeds, streaming the owned ranges before serving reads")
+	flag.StringVar(&replaceReplica, "replace", replaceReplica, "Take over the tokens of this dead replica and rebuild its data from the others (implies -bootstrap)")
+	datacenter := flag.String("dc", Ring.DefaultDatacenter, "Datacenter of this replica (a <dc>:<rack> column in the config file takes precedence)")
+	rack := flag.String("rack", Ring.DefaultRack, "Rack of this replica (a <dc>:<rack> column in the config file takes precedence)")
+	storageEngine := flag.String("storage-engine", storageMemory, "Where rows are kept: memory (rebuilt from <name>Storage.log on reboot) or lsm (memtable and SSTables in <name>Data)")
+	memtableSize := flag.Int("memtable-size", Storage.DefaultMemtableSize, "LSM engine: bytes of writes held in memory before they are flushed to an SSTable")
+	compaction := flag.String("compaction", Storage.CompactionSizeTiered, "LSM engine: SSTable compaction strategy - size-tiered or leveled")
+	compactionThroughput := flag.Int("compaction-throughput", Storage.DefaultCompactionThroughput>>20, "Megabytes per second compaction may write (0: unthrottled); covers SSTables and <name>Storage.log")
+	tombstoneGrace := flag.Duration("tombstone-grace", Storage.DefaultTombstoneGrace, "LSM engine: how long a tombstone is kept before compaction may drop it; repair must run more often")
+	bloomFalsePositive := flag.Float64("bloom-fp-chance", Storage.DefaultFalsePositive, "LSM engine: false positive rate of each new SSTable's bloom filter (0: no filter)")
+	flag.DurationVar(&storageCompactionInterval, "compaction-interval", storageCompactionInterval, "Memory engine: how often <name>Storage.log is checked for compaction (0: never)")
+	commitLogSync := flag.String("commitlog-sync", Storage.SyncPeriodic, "When a write is synced to disk before it is acknowledged: per-write, group (writes share a sync) or periodic (synced every -commitlog-sync-period, acknowledged at once)")
+	commitLogSyncPeriod := flag.Duration("commitlog-sync-period", Storage.DefaultSyncPeriod, "Periodic sync mode: time between syncs of the commit log")
+	commitLogGroupWindow := flag.Duration("commitlog-group-window", Storage.DefaultGroupWindow, "Group sync mode: how long a sync waits for more writes to join it")
+	flag.Parse()
+
+	//Frame Lengths are 32 Bits - a Larger Limit Would Wrap Around to a Small One
+	if *maxMessageSize > math.MaxUint32 {
+		log.Fatal("Max Message Size ", *maxMessageSize, " is Above the Largest Frame (", uint64(math.MaxUint32), " Bytes)")
+	}
+	Transport.SetMaxMessageSize(uint32(*maxMessageSize))
+	compactionThrottle = Storage.NewThrottle(int64(*compactionThroughput) << 20)
+	tokenRing = Ring.New(*vnodes)
+	failureDetector = FailureDetector.New(*phiThreshold, gossipInterval)
+
 	//Get Public IP of the Server
 	replicaIP := IP_Address.GetPublicIP()
 
 	//Receive Input Parameters
-	myConfig.Name = os.Args[1] //Replica Name
+	myConfig.Name = flag.Arg(0) //Replica Name
 	myConfig.IP = replicaIP.String()
-	myConfig.Port = os.Args[2] //Port
+	myConfig.Port = flag.Arg(1) //Port
+	myConfig.Datacenter = *datacenter
+	myConfig.Rack = *rack
+	if *broadcastAddress != "" {
+		myConfig.IP = *broadcastAddress
+	}
+
+	//With Seeds, Gossip Finds the Other Replicas
+	seedList := []string{}
+	if *seeds != "" {
+		seedList = strings.Split(*seeds, ",")
+	}
+	if replaceReplica == myConfig.Name {
+		log.Fatal("A Replica Cannot Replace Itself - Restart it Instead")
+	}
+	bootstrapping.Store(*bootstrap || replaceReplica != "")
+	if bootstrapping.Load() && len(seedList) == 0 {
+		log.Fatal("Bootstrap Needs Seeds (-seeds) to Find the Cluster")
+	}
 
 	//Get Replica Config File Name
-	replicaConfigFile := os.Args[3] //Config. File Name
+	replicaConfigFile := flag.Arg(2) //Config. File Name
 
 	//Get Replica Config File Name
-	isReplicaRebooting = os.Args[4] //Replica Rebooting...?
+	isReplicaRebooting = flag.Arg(3) //Replica Rebooting...?
 
-	//Identify the Mode - Read Repair OR Hinted HandOff
-	if os.Args[5] == "1" {
+	//Identify the Mode - Read Repair, Hinted HandOff, or Both
+	switch flag.Arg(4) {
+	case "1":
+		readRepairMode = true
+	case "2":
+		hintedHandOffMode = true
+	case "3":
 		readRepairMode = true
-	} else if os.Args[5] == "2" {
 		hintedHandOffMode = true
 	}
 
@@ -119,50 +342,131 @@ func main() {
 	} else {
 		fmt.Print("Re-booting in ")
 	}
-	if readRepairMode {
+	if readRepairMode && hintedHandOffMode {
+		fmt.Println("READ-REPAIR & HINTED HAND-OFF MODE.")
+	} else if readRepairMode {
 		fmt.Println("READ-REPAIR MODE.")
-	}
-	if hintedHandOffMode {
+	} else if hintedHandOffMode {
 		fmt.Println("HINTED HAND-OFF MODE.")
 	}
 	fmt.Println("------------------------------------------------")
 
-	//Allocate Memory
-	KeyValueConfig.KeyValues = make(map[uint32]keyConfig)
+	//Keyspaces Survive a Reboot; the Default Keyspace Always Exists
+	KeyspaceConfig.Load(myConfig.Name + "Keyspaces.txt")
+
+	//Storage Engine - Every Row in Memory, Rebuilt from the Storage Log on Reboot; or an LSM Tree
+	//on Disk, which Logs its Own Writes (No Storage Log)
+	fileName := myConfig.Name + "Storage.log"
+	var storageWriter *Storage.CommitLog
+
+	options := Storage.DefaultOptions()
+	options.Sync = *commitLogSync
+	options.SyncPeriod = *commitLogSyncPeriod
+	options.GroupWindow = *commitLogGroupWindow
+
+	switch *storageEngine {
+	case storageMemory:
+
+		KeyValueConfig.Engine = Storage.NewMemory()
+
+		//Cut Off a Write Torn by a Crash - and Load the Rows Back When Rebooting
+		ReloadValue(fileName, isReplicaRebooting == yes)
+
+		var err error
+		storageWriter, err = Storage.OpenCommitLog(fileName, options)
+		if err != nil {
+			log.Fatal(err)
+		}
+		KeyValueConfig.Log = storageWriter
+
+		//The Text Storage File of Older Versions Moves into the Log
+		ImportStorageText(myConfig.Name+"Storage.txt", storageWriter, isReplicaRebooting == yes)
+
+	case storageLSM:
+
+		options.MemtableSize = *memtableSize
+		options.Compaction = *compaction
+		options.CompactionThroughput = int64(*compactionThroughput) << 20
+		options.TombstoneGrace = *tombstoneGrace
+		options.FalsePositive = *bloomFalsePositive
 
-	//Create Replica Storage File
-	fileName := myConfig.Name + "Storage.txt"
-	fileId, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		engine, err := Storage.OpenLSM(myConfig.Name+"Data", options)
+		if err != nil {
+			log.Fatal(err)
+		}
+		KeyValueConfig.Engine = engine
+
+	default:
+		log.Fatal("Unknown Storage Engine ", *storageEngine, " - Expected ", storageMemory, " or ", storageLSM)
+	}
+
+	//Hints Survive a Reboot Too
+	var err error
+	hintStore, err = Hints.Open(myConfig.Name + "Hints")
 	if err != nil {
-		fmt.Println("File Error", err)
+		log.Fatal(err)
+	}
+	for _, target := range hintStore.Targets() {
+		fmt.Println("Pending Hints:", "Replica:", target, "Count:", len(hintStore.Pending(target)))
 	}
-	storageWriter := bufio.NewWriter(fileId)
 
-	//Identify Other Replicas in the Cluster
-	if isReplicaRebooting == yes {
+	//Identify Other Replicas in the Cluster - Unless Gossip Does
+	if isReplicaRebooting == yes && len(seedList) == 0 {
 
 		//Do Replica Setup Using ConfigFile
 		ClusterSetup(replicaConfigFile)
 
-		//Byte Order Partition
-		ByteOrderPartition()
+		//Place the Replicas on the Token Ring
+		BuildTokenRing()
+
+		replicaInitialized.Store(true)
+	}
+
+	//The Storage Log Only Grows - Rewrite it Now and Then with Just the Latest Versions
+	if storageWriter != nil {
+		go StorageCompactor(storageWriter)
+	}
+
+	//gRPC Port: Flag, Else Config File, Else Replica Port + 1
+	if *grpcPort != "" {
+		myConfig.GrpcPort = *grpcPort
+	} else if myConfig.GrpcPort == "" {
+		myConfig.GrpcPort = DefaultGrpcPort(myConfig.Port)
+	}
+
+	//Snitch: This Replica's Own Location
+	tokenRing.SetLocation(myConfig.Name, myConfig.Datacenter, myConfig.Rack)
 
-		//Load Value For the Keys From Persistent Storage
-		ReloadValue(fileName)
+	//Gossip with the Seeds, or with the Configured Replicas Once Initialized
+	StartGossip(seedList)
 
-		replicaInitialized = true
+	//Joining a Running Cluster: Reads are Served Only Once the Owned Ranges are Streamed
+	if bootstrapping.Load() {
+		go Bootstrap(storageWriter)
 	}
 
+	//Serve the gRPC API Alongside the Socket Protocol
+	go ServeGrpc(storageWriter)
+
+	//Deliver Hints to Replicas as they Come Back
+	go HintDispatcher()
+
+	//Scheduled Anti-Entropy Repair
+	go RepairScheduler(storageWriter)
+
 	//Receive Request from Client / Other Replicas
 	ReceiverHandler(storageWriter)
 
 	fmt.Println("Replica Closing...")
+	if err := KeyValueConfig.Close(); err != nil {
+		fmt.Println("Storage Engine Error:", err)
+	}
 
 }
 
 //---------------------------------------------------------------------------//
 
-func ReceiverHandler(storageWriter *bufio.Writer) {
+func ReceiverHandler(storageWriter *Storage.CommitLog) {
 
 	//Listener IP Address Resolver
 	myListener, err := net.ResolveTCPAddr("tcp", myConfig.IP+":"+myConfig.Port)
@@ -194,47 +498,67 @@ func ReceiverHandler(storageWriter *bufio.Writer) {
 
 //---------------------------------------------------------------------------//
 
-func ReplicaReceiverHandler(replicaSocket *net.TCPConn, storageWriter *bufio.Writer) {
+func ReplicaReceiverHandler(replicaConn *net.TCPConn, storageWriter *Storage.CommitLog) {
 
-	//Read Branch Input as Received
-	inpReqBuff := make([]byte, maxBytes)
+	session := Transport.NewSession(replicaConn)
+	defer session.Close()
 
-	_, err := replicaSocket.Read(inpReqBuff)
+	//Keep Serving Requests Until the Peer Closes the Connection
+	for requestCount := 0; ; requestCount++ {
 
-	//If No request to process, return
-	if err == io.EOF {
-		fmt.Println("RECEIVER: Request received as EOF..!!!!!")
-		return
-	}
+		//Read One Complete Request Frame
+		requestMsg := new(cassandra.InputRequest)
+		err := session.Read(requestMsg)
+
+		//If No request to process, return
+		if err == io.EOF {
+			if requestCount == 0 {
+				fmt.Println("RECEIVER: Request received as EOF..!!!!!")
+			}
+			return
+		}
+
+		if err != nil {
+			fmt.Println("RECEIVER: Error while reading request.!", err)
+			return
+		}
+
+		//Requests Sharing this Connection are Processed Concurrently
+		go ProcessRequest(requestMsg, session.ReplyTo(requestMsg.GetRequestId()), storageWriter)
 
-	if err != nil {
-		fmt.Println("RECEIVER: Error while reading request.!", err)
-		return
 	}
 
-	//Un-Marshal the Input Request
-	requestMsg := new(cassandra.InputRequest)
-	proto.Unmarshal(inpReqBuff, requestMsg)
+}
+
+//---------------------------------------------------------------------------//
+
+func ProcessRequest(requestMsg *cassandra.InputRequest, replicaSocket responder, storageWriter *Storage.CommitLog) {
 
 	//1. Replica Init Message
 	if replicaInitMsg := requestMsg.GetInitReplica(); replicaInitMsg != nil {
 
-		if replicaInitialized {
-			fmt.Println("Replica already Initialized.")
+		if !InitializeReplica(replicaInitMsg) {
 			return
 		}
 
-		//Initialize Replica
-		InitializeCluster(*replicaInitMsg)
+	}
 
-		//Map Key Values For the Replicas
-		ByteOrderPartition()
+	//Gossip From Another Replica - Answered Even Before this Replica is Initialized
+	if gossipMsg := requestMsg.GetGossip(); gossipMsg != nil {
 
-		replicaInitialized = true
+		ProcessGossip(gossipMsg, replicaSocket)
+		return
 
 	}
 
-	if !replicaInitialized {
+	if !replicaInitialized.Load() {
+
+		//A Bootstrapping Replica Already Takes the Writes for the Ranges it is Streaming
+		if replicaPutMsg := requestMsg.GetReplicaPut(); replicaPutMsg != nil && bootstrapping.Load() {
+			ProcessReplicaPut(replicaPutMsg, replicaSocket, storageWriter)
+			return
+		}
+
 		fmt.Println("Replica Not Initialized. Request Cannot be processed.")
 		return
 	}
@@ -242,8 +566,20 @@ func ReplicaReceiverHandler(replicaSocket *net.TCPConn, storageWriter *bufio.Wri
 	//2. PUT Request - From Client
 	if clientPutMsg := requestMsg.GetClientPut(); clientPutMsg != nil {
 
+		if len(clientPutMsg.Input.GetKey()) == 0 {
+			InvalidKeyMsg(replicaSocket)
+			return
+		}
+
+		//Requests Without a Keyspace Use the Default Keyspace
+		clientPutMsg.Input.Keyspace = KeyspaceName(clientPutMsg.Input.GetKeyspace())
+		if !KeyspaceConfig.Exists(clientPutMsg.Input.Keyspace) {
+			UnknownKeyspaceMsg(clientPutMsg.Input.Key, clientPutMsg.Input.Keyspace, replicaSocket)
+			return
+		}
+
 		//If not enough replicas are UP, Send Exception to the Client
-		if !CheckReplicaStatus(clientPutMsg.Input.Key, clientPutMsg.Input.Consistency.String()) {
+		if !CheckReplicaStatus(clientPutMsg.Input.Keyspace, clientPutMsg.Input.Key, clientPutMsg.Input.Consistency.String()) {
 			NotEnoughReplicaMsg(clientPutMsg.Input.Key, replicaSocket)
 			return
 		}
@@ -256,27 +592,27 @@ func ReplicaReceiverHandler(replicaSocket *net.TCPConn, storageWriter *bufio.Wri
 	//3. PUT Request - From Replica Coordinator
 	if replicaPutMsg := requestMsg.GetReplicaPut(); replicaPutMsg != nil {
 
-		//Write it to Persistent Storage
-		WriteToStorage(replicaPutMsg.GetInput(), storageWriter)
-
-		//Update In-Memory Value
-		KeyValueConfig.UpdateValue(replicaPutMsg.Input.GetKey(), replicaPutMsg.Input.GetValue(), replicaPutMsg.Input.TimeInSeconds)
-
-		key := replicaPutMsg.Input.GetKey()
-		fmt.Println("Replica PUT:", "Key:", key, "Value:", KeyValueConfig.KeyValues[key].MyValue, "Time:", KeyValueConfig.KeyValues[key].Arrived)
-
-		// **** Hinted HandsOff ****
-		if hintedHandOffMode {
-			HintedHandsOff(replicaPutMsg)
-		}
+		ProcessReplicaPut(replicaPutMsg, replicaSocket, storageWriter)
 
 	}
 
 	//4. GET Request From CLIENT
 	if replicaClientReadMsg := requestMsg.GetClientRead(); replicaClientReadMsg != nil {
 
+		if len(replicaClientReadMsg.GetKey()) == 0 {
+			InvalidKeyMsg(replicaSocket)
+			return
+		}
+
+		//Requests Without a Keyspace Use the Default Keyspace
+		replicaClientReadMsg.Keyspace = KeyspaceName(replicaClientReadMsg.GetKeyspace())
+		if !KeyspaceConfig.Exists(replicaClientReadMsg.Keyspace) {
+			UnknownKeyspaceMsg(replicaClientReadMsg.Key, replicaClientReadMsg.Keyspace, replicaSocket)
+			return
+		}
+
 		//If not enough replicas are UP, Send Exception to the Client
-		if !CheckReplicaStatus(replicaClientReadMsg.Key, replicaClientReadMsg.Consistency.String()) {
+		if !CheckReplicaStatus(replicaClientReadMsg.Keyspace, replicaClientReadMsg.Key, replicaClientReadMsg.Consistency.String()) {
 			NotEnoughReplicaMsg(replicaClientReadMsg.Key, replicaSocket)
 			return
 		}
@@ -294,211 +630,356 @@ func ReplicaReceiverHandler(replicaSocket *net.TCPConn, storageWriter *bufio.Wri
 
 	}
 
+	//6. Keyspace Definition From Replica Coordinator
+	if keyspaceMsg := requestMsg.GetKeyspaceDefinition(); keyspaceMsg != nil {
+
+		//Record the Keyspace and Acknowledge
+		DefineKeyspace(keyspaceMsg, replicaSocket)
+
+	}
+
+	//7. Merkle Tree Request - From a Replica Running Repair
+	if treeReqMsg := requestMsg.GetMerkleTreeRequest(); treeReqMsg != nil {
+
+		SendMerkleTree(treeReqMsg, replicaSocket)
+
+	}
+
+	//8. Repair Rows Request - From a Replica Running Repair
+	if rowsReqMsg := requestMsg.GetRepairRowsRequest(); rowsReqMsg != nil {
+
+		SendRepairRows(rowsReqMsg, replicaSocket)
+
+	}
+
+	//9. Schema Request - From a Bootstrapping Replica
+	if schemaReqMsg := requestMsg.GetSchemaRequest(); schemaReqMsg != nil {
+
+		SendSchema(replicaSocket)
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func ProcessReplicaPut(replicaPutMsg *cassandra.ReplicaPut, replicaSocket responder, storageWriter *Storage.CommitLog) {
+
+	key := replicaPutMsg.Input.GetKey()
+
+	//Write it to Persistent Storage; Acknowledge Only Once it is Written
+	if err := WriteToStorage(replicaPutMsg.GetInput(), storageWriter); err != nil {
+		ReplicaPutAck(key, err, replicaSocket)
+		return
+	}
+
+	//Acknowledge the Coordinator
+	ReplicaPutAck(key, nil, replicaSocket)
+
+	keyspace := KeyspaceName(replicaPutMsg.Input.GetKeyspace())
+	keyValues, _ := KeyValueConfig.ReadValue(keyspace, key)
+	fmt.Println("Replica PUT:", "Keyspace:", keyspace, "Key:", string(key), "Value:", keyValues.MyValue, "Time:", keyValues.Arrived)
+
+	// **** Hinted HandsOff - Hints Exist Outside Hinted HandOff Mode Only for ANY Writes ****
+	HintedHandsOff(replicaPutMsg)
+
 }
 
 //---------------------------------------------------------------------------//
 
-func ProcessClientPutRequest(clientPutMsg *cassandra.ClientPut, storageWriter *bufio.Writer, replicaSocket *net.TCPConn) {
+func ProcessClientPutRequest(clientPutMsg *cassandra.ClientPut, storageWriter *Storage.CommitLog, replicaSocket responder) {
 
 	//Get key Value
 	keyValueRcvd := clientPutMsg.Input.GetKey()
+	keyspace := clientPutMsg.Input.GetKeyspace()
+
+	//Acknowledgements Needed Before the Client is Answered
+	consistency := clientPutMsg.Input.GetConsistency().String()
+	settings := KeyspaceConfig.Settings(keyspace)
+	owners := KeyReplicas(keyspace, keyValueRcvd)
+	quorum := NewReplicaQuorum(consistency, settings.Strategy(), owners)
 
 	//Add Timestamp
 	clientPutMsg.Input.Timestamp, _ = ptypes.TimestampProto(time.Now())
 	clientPutMsg.Input.TimeInSeconds = clientPutMsg.Input.Timestamp.GetSeconds()
 
-	//Count the successful PUT messages
+	//Count the Acknowledged PUT messages
 	clientRespSent := false
-	successCount := 0
+	hintsStored := 0
 
 	//if Key belongs to this Replica, Write the Request to the Persistent Storage
-	if KeyBelongsToMe(keyValueRcvd) {
-
-		//First Replica PUT Done
-		successCount++
+	if KeyBelongsToMe(keyspace, keyValueRcvd) {
 
 		//Write it to Persistent Storage
-		WriteToStorage(clientPutMsg.GetInput(), storageWriter)
+		if err := WriteToStorage(clientPutMsg.GetInput(), storageWriter); err != nil {
+			fmt.Println("Client PUT: Local Write Failed.!", err)
+		} else {
 
-		//Update - UpdateValue
-		KeyValueConfig.UpdateValue(keyValueRcvd, clientPutMsg.Input.GetValue(), clientPutMsg.Input.TimeInSeconds)
+			//First Replica PUT Done
+			quorum.Add(myConfig.Name)
+
+			//If One Acknowledgement is Enough, Send Response to Client and Proceed
+			if quorum.Met() {
+				SendResponseToClient(keyspace, keyValueRcvd, replicaSocket)
+				clientRespSent = true
+			}
 
-		//If Consistency level is set to ONE, Send Response to Client and Proceed
-		if clientPutMsg.Input.GetConsistency().String() == consistencyOne {
-			SendResponseToClient(keyValueRcvd, replicaSocket)
-			clientRespSent = true
 		}
 
 	}
 
-	//Send ReplicaPut Request to Remaining Replicas
-	for _, eachReplica := range myReplicaCluster {
+	//Owners First, then the Replicas that Will Own the Key Once the Ring Change Completes
+	pending := pendingReplicas.Replicas(keyspace, keyValueRcvd)
+
+	//This Replica May be a Next Owner Itself - it Keeps a Copy, Not Counted Toward the Consistency Level
+	if slices.Contains(pending, myConfig.Name) {
+		if err := WriteToStorage(clientPutMsg.GetInput(), storageWriter); err != nil {
+			fmt.Println("Client PUT: Local Write (Pending) Failed.!", err)
+		}
+	}
+
+	clientPutMsg.Input.OriginReplica = myConfig.Name
+
+	//Send ReplicaPut Request to Remaining Replicas in Parallel - Every Datacenter, Even for LOCAL_QUORUM, whose
+	//Client is Answered Once the Local Replicas Acknowledge while the Remote Datacenters Catch Up in the Background
+	ackChan := make(chan replicaAck)
+	replicasSent := 0
 
-		if eachReplica.Name == KeyValueConfig.KeyValues[keyValueRcvd].ReplicaAssigned1 ||
-			eachReplica.Name == KeyValueConfig.KeyValues[keyValueRcvd].ReplicaAssigned2 ||
-			eachReplica.Name == KeyValueConfig.KeyValues[keyValueRcvd].ReplicaAssigned3 {
+	for _, replicaName := range append(owners, pending...) {
+
+		if eachReplica, isPeer := myReplicaCluster.Get(replicaName); isPeer {
 
 			replicaPutMessage := new(cassandra.InputRequest_ReplicaPut)
 			replicaPutMessage.ReplicaPut = new(cassandra.ReplicaPut)
-			replicaPutMessage.ReplicaPut.Input = new(cassandra.RequestParameter)
 			replicaPutMessage.ReplicaPut.Input = clientPutMsg.GetInput()
-			replicaPutMessage.ReplicaPut.Input.OriginReplica = myConfig.Name
 
 			//Input Request Message
 			replicaMsg := new(cassandra.InputRequest)
 			replicaMsg.InputRequest = replicaPutMessage
 
-			//Proto-buf Message
-			protoReplicaPutMsg, _ := proto.Marshal(replicaMsg)
-
-			//Send ReplicaPut Message
-			connection, err := net.DialTCP("tcp", nil, eachReplica.TCPAddress)
+			replicasSent++
 
-			//If Failed, Make Hints
-			if err != nil {
+			//Send ReplicaPut Message and Wait for its Acknowledgement
+			go func(eachReplica replica, replicaMsg *cassandra.InputRequest) {
 
-				if hintedHandOffMode {
+				ack := replicaAck{ReplicaName: eachReplica.Name, Pending: slices.Contains(pending, eachReplica.Name)}
 
-					// Make Hints for Hinted-HandsOff
-					MakeHints(eachReplica.Name, clientPutMsg)
+				respMsg, err := eachReplica.Pool.Call(replicaMsg, replicaRequestTimeout)
+				if err != nil {
+					ack.Err = err
+				} else if !respMsg.GetResponse().GetStatus() {
+					ack.Err = errors.New(respMsg.GetResponse().GetRespMessage())
 				}
 
-			} else { //Else Send PUT Request to Other Replicas
+				ackChan <- ack
 
-				connection.Write(protoReplicaPutMsg)
-				successCount++
+			}(eachReplica, replicaMsg)
 
-				//Check if Client Response Can be Sent
+		}
 
-				//Consistency = ONE
-				if clientPutMsg.Input.GetConsistency().String() == consistencyOne && !clientRespSent {
+	}
 
-					//Send Response to Client as SUCCESS - ONE
-					SendResponseToClient(keyValueRcvd, replicaSocket)
-					clientRespSent = true
-				}
+	//Collect Every Acknowledgement; Each Call Gives Up After replicaRequestTimeout
+	for ; replicasSent > 0; replicasSent-- {
 
-				//Consistency = QUORUM
-				if clientPutMsg.Input.GetConsistency().String() == consistencyQuorum && !clientRespSent && successCount > constOne {
+		ack := <-ackChan
 
-					//Send Response to Client as SUCCESS - QUORUM Consistency
-					SendResponseToClient(keyValueRcvd, replicaSocket)
-					clientRespSent = true
-				}
+		//A Next Owner Gets a Copy, but Neither Counts Toward the Consistency Level nor Gets Hints
+		if ack.Pending {
+			if ack.Err != nil {
+				fmt.Println("Replica PUT Failed (Pending):", ack.ReplicaName, ack.Err)
+			}
+			continue
+		}
+
+		//If Failed, Make Hints
+		if ack.Err != nil {
 
+			fmt.Println("Replica PUT Failed:", ack.ReplicaName, ack.Err)
+
+			//ANY Writes are Always Hinted, Whatever the Mode or Keyspace
+			if (hintedHandOffMode && settings.HintedHandOff) || consistency == consistencyAny {
+
+				// Make Hints for Hinted-HandsOff
+				if MakeHints(ack.ReplicaName, clientPutMsg) == nil {
+					hintsStored++
+				}
 			}
 
+			continue
+		}
+
+		//Else PUT Acknowledged by the Other Replica
+		quorum.Add(ack.ReplicaName)
+
+		//Check if Client Response Can be Sent
+		if !clientRespSent && quorum.Met() {
+
+			//Send Response to Client as SUCCESS
+			SendResponseToClient(keyspace, keyValueRcvd, replicaSocket)
+			clientRespSent = true
 		}
 
 	}
 
+	//ANY: No Owner Acknowledged, but a Hint Holds the Write
+	if !clientRespSent && consistency == consistencyAny && hintsStored > 0 {
+		SendResponseToClient(keyspace, keyValueRcvd, replicaSocket)
+		clientRespSent = true
+	}
+
+	//Not Enough Replicas Acknowledged - The Client Gets a Failure
+	if !clientRespSent {
+		WriteFailedMsg(keyValueRcvd, quorum, replicaSocket)
+	}
+
 }
 
 //---------------------------------------------------------------------------//
 
-func ProcessClientReadRequest(replicaClientReadMsg *cassandra.ClientRead, replicaSocket *net.TCPConn) {
+func ProcessClientReadRequest(replicaClientReadMsg *cassandra.ClientRead, replicaSocket responder) {
 
 	keyValueRcvd := replicaClientReadMsg.GetKey()
+	keyspace := replicaClientReadMsg.GetKeyspace()
+
+	//Responses Needed Before the Client is Answered
+	owners := KeyReplicas(keyspace, keyValueRcvd)
+	quorum := NewReplicaQuorum(replicaClientReadMsg.GetConsistency().String(), KeyspaceConfig.Strategy(keyspace), owners)
 
 	//To Check the latest Value
 	readRepairLog := make(map[string]latestVal)
 	finalValOfThisKey := new(latestVal)
+	clientRespSent := false
+
+	//One Replica Returns the Value (the Data Read), the Rest Only a Digest
+	dataReplica := ""
+	dataDone := false
+
+	//Check Key Belongs to this Replica - If So, its Own Copy is the Data Read
+	if KeyBelongsToMe(keyspace, keyValueRcvd) {
 
-	//Check Key Belongs to this Replica
-	if KeyBelongsToMe(keyValueRcvd) {
+		keyValues, err := KeyValueConfig.ReadValue(keyspace, keyValueRcvd)
+		if err != nil {
+			fmt.Println("Client Read: Local Read Failed.!", err)
+		} else {
 
-		if keyValues := KeyValueConfig.ReadValue(keyValueRcvd); keyValues.MyValue != "" {
 			latestValOfThiskey := new(latestVal)
+			latestValOfThiskey.Keyspace = keyspace
 			latestValOfThiskey.Key = keyValueRcvd
 			latestValOfThiskey.Value = keyValues.MyValue
 			latestValOfThiskey.Arrived = keyValues.Arrived
 			latestValOfThiskey.Replica = myConfig.Name
+			latestValOfThiskey.Digest = ValueDigest(keyValues.MyValue, keyValues.Arrived)
+			latestValOfThiskey.HasValue = true
 			finalValOfThisKey = latestValOfThiskey
 			readRepairLog[myConfig.Name] = *latestValOfThiskey
+
+			dataReplica = myConfig.Name
+			dataDone = true
+			quorum.Add(myConfig.Name)
+
 		}
 
 	}
 
-	//Read Value from all Other Replicas
-	for _, eachReplica := range myReplicaCluster {
+	//The Local Read Alone May Meet the Consistency (ONE, RF=1, or a Local Quorum of One)
+	if !clientRespSent && dataDone && quorum.Met() {
+		SendReadResponse(keyspace, keyValueRcvd, *finalValOfThisKey, replicaSocket)
+		clientRespSent = true
+	}
 
-		if eachReplica.Name == KeyValueConfig.KeyValues[keyValueRcvd].ReplicaAssigned1 ||
-			eachReplica.Name == KeyValueConfig.KeyValues[keyValueRcvd].ReplicaAssigned2 ||
-			eachReplica.Name == KeyValueConfig.KeyValues[keyValueRcvd].ReplicaAssigned3 {
+	//Read Value from all Other Replicas in Parallel
+	readChan := make(chan replicaReadResult)
+	replicasSent := 0
 
-			replicaReadMessage := new(cassandra.InputRequest_ReplicaRead)
-			replicaReadMessage.ReplicaRead = new(cassandra.ReplicaRead)
-			replicaReadMessage.ReplicaRead.Key = keyValueRcvd
+	for _, replicaName := range owners {
 
-			//Input Request Message
-			replicaMsg := new(cassandra.InputRequest)
-			replicaMsg.InputRequest = replicaReadMessage
+		//LOCAL_QUORUM Reads Stay in the Coordinator's Datacenter
+		if !quorum.Counts(replicaName) {
+			continue
+		}
 
-			//Proto-buf Message
-			protoReplicaReadMsg, _ := proto.Marshal(replicaMsg)
+		if eachReplica, isPeer := myReplicaCluster.Get(replicaName); isPeer {
 
-			//Send ReplicaPut Message
-			connection, err := net.DialTCP("tcp", nil, eachReplica.TCPAddress)
+			digestRead := true
+			if dataReplica == "" {
+				dataReplica = replicaName
+				digestRead = false
+			}
 
-			//If Failed, Make Hints for Read-Replica
-			if err == nil {
+			replicasSent++
 
-				connection.Write(protoReplicaReadMsg)
+			//Send ReplicaRead Message and Wait for its Response
+			go func(eachReplica replica, digestRead bool) {
 
-				respBuff := make([]byte, maxBytes)
-				connection.Read(respBuff)
+				result := replicaReadResult{ReplicaName: eachReplica.Name}
+				result.Response, result.Err = ReadFromReplica(eachReplica, keyspace, keyValueRcvd, digestRead)
 
-				respMsg := new(cassandra.InputRequest)
-				proto.Unmarshal(respBuff, respMsg)
+				readChan <- result
 
-				replicaResponse := respMsg.GetResponse()
+			}(eachReplica, digestRead)
 
-				if replicaResponse.GetValue() != " " {
+		}
 
-					latestValOfThiskey := new(latestVal)
-					latestValOfThiskey.Key = keyValueRcvd
-					latestValOfThiskey.Value = replicaResponse.GetValue()
-					latestValOfThiskey.Replica = replicaResponse.GetOriginReplica()
-					latestValOfThiskey.Arrived = replicaResponse.GetArrival()
-					readRepairLog[latestValOfThiskey.Replica] = *latestValOfThiskey
+	}
 
-					//Check If the other Replica value is latest
-					if latestValOfThiskey.Arrived > finalValOfThisKey.Arrived {
-						finalValOfThisKey = latestValOfThiskey
-					}
+	//Answer the Client Once Enough Replicas Respond, Including the Data Read; Later Responses Only Feed Read Repair
+	for ; replicasSent > 0; replicasSent-- {
 
-				}
+		result := <-readChan
+
+		//If Responded, Collect the Value (or Digest) from the Replica
+		if result.Err != nil {
+			fmt.Println("Replica Read Failed:", result.ReplicaName, result.Err)
+		} else {
+
+			quorum.Add(result.ReplicaName)
+			replicaResponse := result.Response
 
+			latestValOfThiskey := new(latestVal)
+			latestValOfThiskey.Keyspace = keyspace
+			latestValOfThiskey.Key = keyValueRcvd
+			latestValOfThiskey.Replica = result.ReplicaName
+			latestValOfThiskey.Arrived = replicaResponse.GetArrival()
+			latestValOfThiskey.Digest = replicaResponse.GetDigest()
+
+			if result.ReplicaName == dataReplica {
+				latestValOfThiskey.Value = replicaResponse.GetValue()
+				latestValOfThiskey.Digest = ValueDigest(latestValOfThiskey.Value, latestValOfThiskey.Arrived)
+				latestValOfThiskey.HasValue = true
+
+				//Check If the other Replica value is latest
+				if latestValOfThiskey.Arrived > finalValOfThisKey.Arrived {
+					finalValOfThisKey = latestValOfThiskey
+				}
 			}
-		}
 
-	}
+			readRepairLog[result.ReplicaName] = *latestValOfThiskey
 
-	//Send the Final Value of this key to CLIENT
-	clientResponse := new(cassandra.InputRequest_Response)
-	clientResponse.Response = new(cassandra.Response)
-	clientResponse.Response.Key = keyValueRcvd
+		}
 
-	if finalValOfThisKey.Value != "" {
-		clientResponse.Response.Value = finalValOfThisKey.Value
-		clientResponse.Response.Status = true
-		clientResponse.Response.RespMessage = "Value Retrieved Successfully.!"
-	} else {
-		clientResponse.Response.Status = false
-		clientResponse.Response.RespMessage = "Unable to Locate the Key-Value Pair"
+		if result.ReplicaName == dataReplica {
+			dataDone = true
+		}
 
-	}
+		if !clientRespSent && dataDone && quorum.Met() {
 
-	sendResponse := new(cassandra.InputRequest)
-	sendResponse.InputRequest = clientResponse
+			//Fetch the Full Value Only Where a Digest Disagrees
+			finalValOfThisKey = ResolveDigests(*finalValOfThisKey, readRepairLog)
+			SendReadResponse(keyspace, keyValueRcvd, *finalValOfThisKey, replicaSocket)
+			clientRespSent = true
+		}
 
-	protoRespMsg, _ := proto.Marshal(sendResponse)
-	replicaSocket.Write(protoRespMsg)
+	}
 
-	fmt.Println("Client Read: ", "Key:", clientResponse.Response.Key, "Value:", clientResponse.Response.Value, "Time:", clientResponse.Response.Arrival)
+	//Not Enough Replicas Responded - The Client Gets a Failure
+	if !clientRespSent {
+		ReadFailedMsg(keyValueRcvd, quorum, replicaSocket)
+	}
 
-	//Do Read Repair
-	if readRepairMode {
+	//Do Read Repair on the Keyspace's Share of Reads - Late Digests May Still Show a Newer Value
+	if readRepairMode && rand.Float64() < KeyspaceConfig.Settings(keyspace).ReadRepairChance {
+		finalValOfThisKey = ResolveDigests(*finalValOfThisKey, readRepairLog)
 		ReadRepair(*finalValOfThisKey, readRepairLog)
 	}
 
@@ -506,459 +987,3023 @@ func ProcessClientReadRequest(replicaClientReadMsg *cassandra.ClientRead, replic
 
 //---------------------------------------------------------------------------//
 
-func ReplicaRead(replicaReadReadMsg cassandra.ReplicaRead, replicaSocket *net.TCPConn) {
+//Digest Mismatch: a Digest from a Newer (or Equally New) Write Means its Value Must be Fetched;
+//an Older Timestamp is Simply Stale and is Left to Read Repair
+func ResolveDigests(finalValOfThisKey latestVal, readRepairLog map[string]latestVal) *latestVal {
 
-	//Key Value
-	keyValueRcvd := replicaReadReadMsg.GetKey()
+	finalDigest := ValueDigest(finalValOfThisKey.Value, finalValOfThisKey.Arrived)
 
-	//Read the Current Value
-	keyValues := KeyValueConfig.ReadValue(keyValueRcvd)
+	for replicaName, eachReplicaVal := range readRepairLog {
 
-	//Send Response to Client
-	replicaResponse := new(cassandra.InputRequest_Response)
-	replicaResponse.Response = new(cassandra.Response)
-	replicaResponse.Response.Key = keyValueRcvd
-	replicaResponse.Response.OriginReplica = myConfig.Name
-	replicaResponse.Response.Value = keyValues.MyValue
-	replicaResponse.Response.Arrival = keyValues.Arrived
-	replicaResponse.Response.Status = true
-	replicaResponse.Response.RespMessage = myConfig.Name + "Success"
+		if eachReplicaVal.HasValue || bytes.Equal(eachReplicaVal.Digest, finalDigest) || eachReplicaVal.Arrived < finalValOfThisKey.Arrived {
+			continue
+		}
 
-	sendResponse := new(cassandra.InputRequest)
-	sendResponse.InputRequest = replicaResponse
+		fmt.Println("Digest Mismatch:", "Replica:", replicaName, "Key:", string(finalValOfThisKey.Key))
+
+		eachReplica, isPeer := myReplicaCluster.Get(replicaName)
+		if !isPeer {
+			continue
+		}
+
+		//Escalate to a Full Read
+		replicaResponse, err := ReadFromReplica(eachReplica, finalValOfThisKey.Keyspace, finalValOfThisKey.Key, false)
+		if err != nil {
+			fmt.Println("Replica Read Failed:", replicaName, err)
+			continue
+		}
 
-	protoRespMsg, _ := proto.Marshal(sendResponse)
-	replicaSocket.Write(protoRespMsg)
+		eachReplicaVal.Value = replicaResponse.GetValue()
+		eachReplicaVal.Arrived = replicaResponse.GetArrival()
+		eachReplicaVal.Digest = ValueDigest(eachReplicaVal.Value, eachReplicaVal.Arrived)
+		eachReplicaVal.HasValue = true
+		readRepairLog[replicaName] = eachReplicaVal
+
+		//Same Timestamp, Different Value: the Greater Value Wins, so Every Coordinator Agrees
+		if eachReplicaVal.Arrived > finalValOfThisKey.Arrived ||
+			(eachReplicaVal.Arrived == finalValOfThisKey.Arrived && eachReplicaVal.Value > finalValOfThisKey.Value) {
+			finalValOfThisKey = eachReplicaVal
+			finalDigest = eachReplicaVal.Digest
+		}
 
-	fmt.Println("Replica Read:", "Key:", keyValueRcvd, " Value:", keyValues.MyValue, "Time:", keyValues.Arrived)
+	}
 
+	return &finalValOfThisKey
 }
 
 //---------------------------------------------------------------------------//
 
-func HintedHandsOff(replicaPutMsg *cassandra.ReplicaPut) {
+//Send a ReplicaRead and Wait for its Response; a Digest Read Returns No Value
+func ReadFromReplica(eachReplica replica, keyspace string, key []byte, digestRead bool) (*cassandra.Response, error) {
 
-	for hintKey, hint := range hintedHandOff {
+	replicaReadMessage := new(cassandra.InputRequest_ReplicaRead)
+	replicaReadMessage.ReplicaRead = new(cassandra.ReplicaRead)
+	replicaReadMessage.ReplicaRead.Key = key
+	replicaReadMessage.ReplicaRead.Keyspace = keyspace
+	replicaReadMessage.ReplicaRead.Digest = digestRead
 
-		if replicaPutMsg.Input.OriginReplica == hint.ReplicaName {
+	//Input Request Message
+	replicaMsg := new(cassandra.InputRequest)
+	replicaMsg.InputRequest = replicaReadMessage
 
-			newReplicaPutMessage := new(cassandra.InputRequest_ReplicaPut)
-			newReplicaPutMessage.ReplicaPut = new(cassandra.ReplicaPut)
-			newReplicaPutMessage.ReplicaPut.Input = new(cassandra.RequestParameter)
-			newReplicaPutMessage.ReplicaPut.Input.Key = hint.Key
-			newReplicaPutMessage.ReplicaPut.Input.Value = hint.Value
-			newReplicaPutMessage.ReplicaPut.Input.TimeInSeconds = hint.Arrived
-			newReplicaPutMessage.ReplicaPut.Input.OriginReplica = myConfig.Name
+	respMsg, err := eachReplica.Pool.Call(replicaMsg, replicaRequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if !respMsg.GetResponse().GetStatus() {
+		return nil, errors.New(respMsg.GetResponse().GetRespMessage())
+	}
 
-			//Input Request Message
-			replicaMsg := new(cassandra.InputRequest)
-			replicaMsg.InputRequest = newReplicaPutMessage
+	return respMsg.GetResponse(), nil
+}
 
-			//Proto-buf Message
-			protoReplicaPutMsg, _ := proto.Marshal(replicaMsg)
+//---------------------------------------------------------------------------//
 
-			//Send ReplicaPut Message
-			connection, _ := net.DialTCP("tcp", nil, myReplicaCluster[hint.ReplicaName].TCPAddress)
+func ValueDigest(value string, arrived int64) []byte {
 
-			//Send Hinted HandsOff
-			connection.Write(protoReplicaPutMsg)
+	digest := md5.Sum([]byte(value + separator + strconv.FormatInt(arrived, 10)))
 
-			//Delete the Hint
-			delete(hintedHandOff, hintKey)
+	return digest[:]
+}
+
+//---------------------------------------------------------------------------//
+
+//Send the Final Value of this key to CLIENT
+func SendReadResponse(keyspace string, key []byte, finalValOfThisKey latestVal, replicaSocket responder) {
+
+	clientResponse := new(cassandra.InputRequest_Response)
+	clientResponse.Response = new(cassandra.Response)
+	clientResponse.Response.Key = key
+
+	if finalValOfThisKey.Value != "" {
+		clientResponse.Response.Value = finalValOfThisKey.Value
+		clientResponse.Response.Arrival = finalValOfThisKey.Arrived
+		clientResponse.Response.Status = true
+		clientResponse.Response.RespMessage = "Value Retrieved Successfully.!"
+	} else {
+		clientResponse.Response.Status = false
+		clientResponse.Response.RespMessage = "Unable to Locate the Key-Value Pair"
+		clientResponse.Response.Code = uint32(codes.NotFound)
+
+	}
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = clientResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Client Read: Error while sending response.!", err)
+	}
+
+	fmt.Println("Client Read: ", "Keyspace:", keyspace, "Key:", string(clientResponse.Response.Key), "Value:", clientResponse.Response.Value, "Time:", clientResponse.Response.Arrival)
+
+}
+
+//---------------------------------------------------------------------------//
+
+func ReplicaRead(replicaReadReadMsg cassandra.ReplicaRead, replicaSocket responder) {
+
+	//Key Value
+	keyValueRcvd := replicaReadReadMsg.GetKey()
+	keyspace := KeyspaceName(replicaReadReadMsg.GetKeyspace())
+
+	//Read the Current Value
+	keyValues, err := KeyValueConfig.ReadValue(keyspace, keyValueRcvd)
+	if err != nil {
+		StorageErrorMsg(keyValueRcvd, err, replicaSocket)
+		return
+	}
+
+	//Send Response to Client
+	replicaResponse := new(cassandra.InputRequest_Response)
+	replicaResponse.Response = new(cassandra.Response)
+	replicaResponse.Response.Key = keyValueRcvd
+	replicaResponse.Response.OriginReplica = myConfig.Name
+	replicaResponse.Response.Arrival = keyValues.Arrived
+	replicaResponse.Response.Status = true
+	replicaResponse.Response.RespMessage = myConfig.Name + "Success"
+
+	//Digest Read - Send the Hash of the Value Instead of the Value
+	if replicaReadReadMsg.GetDigest() {
+		replicaResponse.Response.Digest = ValueDigest(keyValues.MyValue, keyValues.Arrived)
+	} else {
+		replicaResponse.Response.Value = keyValues.MyValue
+	}
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = replicaResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Replica Read: Error while sending response.!", err)
+	}
+
+	fmt.Println("Replica Read:", "Keyspace:", keyspace, "Key:", string(keyValueRcvd), " Value:", keyValues.MyValue, "Time:", keyValues.Arrived,
+		"Digest:", replicaReadReadMsg.GetDigest())
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Coordinator: Define the Keyspace Here, then on Every Other Replica
+func ProcessCreateKeyspaceRequest(keyspaceMsg *cassandra.KeyspaceDefinition) *cassandra.Response {
+
+	keyspace := keyspaceMsg.GetName()
+	strategy := KeyspaceSettings(keyspaceMsg).Strategy()
+
+	keyspaceResponse := new(cassandra.Response)
+	keyspaceResponse.OriginReplica = myConfig.Name
+
+	if err := KeyspaceConfig.Define(keyspace, KeyspaceSettings(keyspaceMsg)); err != nil {
+		keyspaceResponse.Status = false
+		keyspaceResponse.RespMessage = status.Convert(err).Message()
+		keyspaceResponse.Code = uint32(status.Code(err))
+		return keyspaceResponse
+	}
+
+	//Send the Definition to all Other Replicas
+	replicaAcks := 0
+	for _, eachReplica := range myReplicaCluster.All() {
+
+		keyspaceMessage := new(cassandra.InputRequest_KeyspaceDefinition)
+		keyspaceMessage.KeyspaceDefinition = keyspaceMsg
+
+		//Input Request Message
+		replicaMsg := new(cassandra.InputRequest)
+		replicaMsg.InputRequest = keyspaceMessage
+
+		respMsg, err := eachReplica.Pool.Call(replicaMsg, replicaRequestTimeout)
+		if err != nil {
+			fmt.Println("Create Keyspace Failed:", eachReplica.Name, err)
+			continue
+		}
+
+		if respMsg.GetResponse().GetStatus() {
+			replicaAcks++
+		} else {
+			fmt.Println("Create Keyspace Rejected:", eachReplica.Name, respMsg.GetResponse().GetRespMessage())
+		}
+
+	}
+
+	keyspaceResponse.Status = true
+	keyspaceResponse.RespMessage = fmt.Sprintf("Keyspace %s Created with %s. %d of %d Replicas Acknowledged.",
+		keyspace, strategy, replicaAcks+1, myReplicaCluster.Count()+1)
+
+	fmt.Println("Create Keyspace:", "Keyspace:", keyspace, "Replication:", strategy,
+		"Read Repair Chance:", keyspaceMsg.GetReadRepairChance(), "Hinted Hand-Off:", keyspaceMsg.GetHintedHandoff(), "Acknowledged:", replicaAcks+1)
+
+	return keyspaceResponse
+}
+
+//---------------------------------------------------------------------------//
+
+//Replica: Record a Keyspace Defined by the Coordinator
+func DefineKeyspace(keyspaceMsg *cassandra.KeyspaceDefinition, replicaSocket responder) {
+
+	replicaResponse := new(cassandra.InputRequest_Response)
+	replicaResponse.Response = new(cassandra.Response)
+	replicaResponse.Response.OriginReplica = myConfig.Name
+	replicaResponse.Response.Status = true
+	replicaResponse.Response.RespMessage = myConfig.Name + "Success"
+
+	if err := KeyspaceConfig.Define(keyspaceMsg.GetName(), KeyspaceSettings(keyspaceMsg)); err != nil {
+		replicaResponse.Response.Status = false
+		replicaResponse.Response.RespMessage = status.Convert(err).Message()
+		replicaResponse.Response.Code = uint32(status.Code(err))
+	}
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = replicaResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Define Keyspace: Error while sending response.!", err)
+	}
+
+	fmt.Println("Define Keyspace:", "Keyspace:", keyspaceMsg.GetName(), "Replication:", KeyspaceSettings(keyspaceMsg).Strategy(),
+		"Read Repair Chance:", keyspaceMsg.GetReadRepairChance(), "Hinted Hand-Off:", keyspaceMsg.GetHintedHandoff(),
+		"Status:", replicaResponse.Response.Status)
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Keyspace Settings Carried by a Definition
+func KeyspaceSettings(keyspaceMsg *cassandra.KeyspaceDefinition) keyspaceSettings {
+
+	settings := keyspaceSettings{}
+	settings.ReplicationFactor = int(keyspaceMsg.GetReplicationFactor())
+	settings.ReadRepairChance = keyspaceMsg.GetReadRepairChance()
+	settings.HintedHandOff = keyspaceMsg.GetHintedHandoff()
+
+	//NetworkTopologyStrategy: the Replication Factor is the Sum Over the Datacenters
+	if len(keyspaceMsg.GetDatacenterReplication()) > 0 {
+		settings.Datacenters = make(map[string]int)
+		for datacenter, datacenterReplicas := range keyspaceMsg.GetDatacenterReplication() {
+			settings.Datacenters[datacenter] = int(datacenterReplicas)
+		}
+		settings.ReplicationFactor = settings.Strategy().Replicas()
+	}
+
+	return settings
+}
+
+//---------------------------------------------------------------------------//
+
+//The Replica Sent Us a Write, so it is Back - Deliver its Hints Without Waiting for the Dispatcher
+func HintedHandsOff(replicaPutMsg *cassandra.ReplicaPut) {
+
+	go DeliverHints(replicaPutMsg.Input.OriginReplica)
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Background Hint Delivery: Expire Old Hints, then Deliver to Every Target that Can be Reached
+func HintDispatcher() {
+
+	for {
+
+		time.Sleep(hintDeliveryInterval)
+
+		if !replicaInitialized.Load() {
+			continue
+		}
+
+		if expired, err := hintStore.Expire(time.Now().Add(-hintWindow).Unix()); err != nil {
+			fmt.Println("Hint Expiry: Error while deleting hints.!", err)
+		} else if expired > 0 {
+			fmt.Println("Hint Expiry:", expired, "Hints Older than", hintWindow, "Dropped")
+		}
+
+		for _, target := range hintStore.Targets() {
+
+			//Reachable Once the Failure Detector Sees it UP
+			if _, isPeer := myReplicaCluster.Get(target); isPeer && failureDetector.Alive(target) {
+				go DeliverHints(target)
+			}
+
+		}
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Stream the Target's Hints, Throttled; a Hint is Deleted Only After the Target Acknowledges it
+func DeliverHints(target string) {
+
+	targetReplica, isPeer := myReplicaCluster.Get(target)
+	if !isPeer {
+		return
+	}
+
+	//One Delivery per Target at a Time
+	hintDeliveries.mtx.Lock()
+	if hintDeliveries.active[target] {
+		hintDeliveries.mtx.Unlock()
+		return
+	}
+	hintDeliveries.active[target] = true
+	hintDeliveries.mtx.Unlock()
+
+	defer func() {
+		hintDeliveries.mtx.Lock()
+		delete(hintDeliveries.active, target)
+		hintDeliveries.mtx.Unlock()
+	}()
+
+	throttle := time.Second / time.Duration(max(hintDeliveryRate, 1))
+	delivered := []*cassandra.Hint{}
+	cutoff := time.Now().Add(-hintWindow).Unix()
+
+	for _, hint := range hintStore.Pending(target) {
+
+		//Expired Hints are Dropped, Not Delivered
+		if hint.GetCreated() < cutoff {
+			delivered = append(delivered, hint)
+			continue
+		}
+
+		newReplicaPutMessage := new(cassandra.InputRequest_ReplicaPut)
+		newReplicaPutMessage.ReplicaPut = new(cassandra.ReplicaPut)
+		newReplicaPutMessage.ReplicaPut.Input = new(cassandra.RequestParameter)
+		newReplicaPutMessage.ReplicaPut.Input.Keyspace = hint.GetKeyspace()
+		newReplicaPutMessage.ReplicaPut.Input.Key = hint.GetKey()
+		newReplicaPutMessage.ReplicaPut.Input.Value = hint.GetValue()
+		newReplicaPutMessage.ReplicaPut.Input.TimeInSeconds = hint.GetTimeInSeconds()
+		newReplicaPutMessage.ReplicaPut.Input.OriginReplica = myConfig.Name
+
+		//Input Request Message
+		replicaMsg := new(cassandra.InputRequest)
+		replicaMsg.InputRequest = newReplicaPutMessage
+
+		//Send Hinted HandsOff and Wait for the Acknowledgement; Stop at the First Failure
+		respMsg, err := targetReplica.Pool.Call(replicaMsg, replicaRequestTimeout)
+		if err == nil && !respMsg.GetResponse().GetStatus() {
+			err = errors.New(respMsg.GetResponse().GetRespMessage())
+		}
+		if err != nil {
+			fmt.Println("Hinted-HandOff Failed: Replica:", target, err)
+			break
+		}
+
+		delivered = append(delivered, hint)
+
+		fmt.Println("Hinted-HandOff: Replica:", target, "Key:", string(hint.GetKey()), "Value",
+			hint.GetValue(), "Time:", hint.GetTimeInSeconds())
+
+		time.Sleep(throttle)
+
+	}
+
+	//Delete the Acknowledged Hints
+	if err := hintStore.Remove(target, delivered); err != nil {
+		fmt.Println("Hinted-HandOff: Error while deleting hints.!", err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func ReadRepair(finalValOfThisKey latestVal, readRepairLog map[string]latestVal) {
+
+	for _, eachReplicaVal := range readRepairLog {
+
+		if finalValOfThisKey.Arrived > eachReplicaVal.Arrived {
+
+			//Stale Value Can be From Coordinator
+			if eachReplicaVal.Replica == myConfig.Name {
+
+				if err := KeyValueConfig.UpdateValue(finalValOfThisKey.Keyspace, finalValOfThisKey.Key, finalValOfThisKey.Value, finalValOfThisKey.Arrived); err != nil {
+					fmt.Println("Read Repair: Local Update Failed.!", err)
+				}
+
+			} else {
+
+				//Stale Value from Other Replicas
+
+				replicaPutMessage := new(cassandra.InputRequest_ReplicaPut)
+				replicaPutMessage.ReplicaPut = new(cassandra.ReplicaPut)
+				replicaPutMessage.ReplicaPut.Input = new(cassandra.RequestParameter)
+
+				replicaPutMessage.ReplicaPut.Input.Keyspace = finalValOfThisKey.Keyspace
+				replicaPutMessage.ReplicaPut.Input.Key = finalValOfThisKey.Key
+				replicaPutMessage.ReplicaPut.Input.Value = finalValOfThisKey.Value
+				replicaPutMessage.ReplicaPut.Input.OriginReplica = finalValOfThisKey.Replica
+				replicaPutMessage.ReplicaPut.Input.TimeInSeconds = finalValOfThisKey.Arrived
+
+				//Input Request Message
+				replicaMsg := new(cassandra.InputRequest)
+				replicaMsg.InputRequest = replicaPutMessage
+
+				//Send ReplicaPut Message
+				staleReplica, isPeer := myReplicaCluster.Get(eachReplicaVal.Replica)
+				if !isPeer {
+					continue
+				}
+				if err := staleReplica.Pool.Send(replicaMsg); err != nil {
+					continue
+				}
+
+				fmt.Println("Read Repair:", "Replica:", eachReplicaVal.Replica, "Key:", string(finalValOfThisKey.Key),
+				"Value:", finalValOfThisKey.Value, "Time:", finalValOfThisKey.Arrived)
+			}
+
+		}
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Run the Anti-Entropy Repair on a Schedule (When an Interval is Set)
+func RepairScheduler(storageWriter *Storage.CommitLog) {
+
+	if repairInterval <= 0 {
+		return
+	}
+
+	for {
+
+		time.Sleep(repairInterval)
+
+		if !replicaInitialized.Load() {
+			continue
+		}
+
+		repairResponse := ProcessRepairRequest(new(cassandra.RepairRequest), storageWriter)
+		fmt.Println("Scheduled Repair:", repairResponse.GetRespMessage())
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Anti-Entropy Repair: Compare Every Range this Replica Holds with the Other Replicas Holding it,
+//and Exchange Only the Rows that Differ - Reaches Keys that are Never Read
+func ProcessRepairRequest(repairMsg *cassandra.RepairRequest, storageWriter *Storage.CommitLog) *cassandra.Response {
+
+	repairResponse := new(cassandra.Response)
+	repairResponse.OriginReplica = myConfig.Name
+
+	keyspaces := KeyspaceConfig.Names()
+	if repairMsg.GetKeyspace() != "" {
+
+		if !KeyspaceConfig.Exists(repairMsg.GetKeyspace()) {
+			repairResponse.RespMessage = "Keyspace " + repairMsg.GetKeyspace() + " Does Not Exist."
+			repairResponse.Code = uint32(codes.NotFound)
+			return repairResponse
+		}
+
+		keyspaces = []string{repairMsg.GetKeyspace()}
+	}
+
+	//One Repair at a Time
+	repairs.mtx.Lock()
+	if repairs.running {
+		repairs.mtx.Unlock()
+		repairResponse.RespMessage = "A Repair is Already Running on " + myConfig.Name + "."
+		repairResponse.Code = uint32(codes.Aborted)
+		return repairResponse
+	}
+	repairs.running = true
+	repairs.mtx.Unlock()
+
+	defer func() {
+		repairs.mtx.Lock()
+		repairs.running = false
+		repairs.mtx.Unlock()
+	}()
+
+	comparisons, rowsReceived, rowsSent, failures := 0, 0, 0, 0
+
+	for _, keyspace := range keyspaces {
+
+		tokenRanges := []Ring.Range{}
+		for _, tokenRange := range tokenRing.Ranges(KeyspaceConfig.Strategy(keyspace)) {
+			if slices.Contains(tokenRange.Replicas, myConfig.Name) {
+				tokenRanges = append(tokenRanges, tokenRange)
+			}
+		}
+
+		//This Replica's Trees of Every Range it Holds, from One Pass over its Rows
+		localTrees, err := KeyspaceMerkleTrees(keyspace, tokenRanges, repairDepth)
+		if err != nil {
+			fmt.Println("Repair Failed:", "Keyspace:", keyspace, err)
+			failures++
+			continue
+		}
+
+		for i, tokenRange := range tokenRanges {
+
+			for _, replicaName := range tokenRange.Replicas {
+
+				eachReplica, isPeer := myReplicaCluster.Get(replicaName)
+				if !isPeer {
+					continue
+				}
+
+				received, sent, err := RepairRange(eachReplica, keyspace, localTrees[i], storageWriter)
+				if err != nil {
+					fmt.Println("Repair Failed:", "Replica:", replicaName, "Keyspace:", keyspace, err)
+					failures++
+					continue
+				}
+
+				comparisons++
+				rowsReceived += received
+				rowsSent += sent
+
+				//Rows Received Change the Range - the Next Replica is Compared Against the New Tree
+				if received > 0 {
+					localTrees[i], err = KeyspaceMerkleTree(keyspace, tokenRange.Start, tokenRange.End, repairDepth)
+					if err != nil {
+						fmt.Println("Repair Failed:", "Keyspace:", keyspace, err)
+						failures++
+						break
+					}
+				}
+
+			}
+
+		}
+
+	}
+
+	repairResponse.Status = failures == 0
+	repairResponse.RespMessage = fmt.Sprintf("Repair: %d Range Comparisons, %d Rows Received, %d Rows Sent, %d Failed.",
+		comparisons, rowsReceived, rowsSent, failures)
+	if failures > 0 {
+		repairResponse.Code = uint32(codes.Unavailable)
+	}
+
+	fmt.Println(myConfig.Name, repairResponse.RespMessage)
+
+	return repairResponse
+}
+
+//---------------------------------------------------------------------------//
+
+//Leave the Cluster for Good: Gossip LEAVING so Coordinators Send the Next Owners the New Writes,
+//Stream Every Range this Replica Holds to the Replicas that Will Hold it Next, Hand Off Pending
+//Hints, then Gossip LEFT and Exit. On Any Failure the Replica Stays NORMAL
+func ProcessDecommissionRequest() *cassandra.Response {
+
+	decommissionResponse := new(cassandra.Response)
+	decommissionResponse.OriginReplica = myConfig.Name
+
+	//The Replicas Left Behind Must Still Hold Every Keyspace's Replicas
+	if shortfall := DecommissionShortfall(); shortfall != "" {
+		decommissionResponse.RespMessage = shortfall
+		decommissionResponse.Code = uint32(codes.FailedPrecondition)
+		return decommissionResponse
+	}
+
+	decommission.mtx.Lock()
+	if decommission.started {
+		decommission.mtx.Unlock()
+		decommissionResponse.RespMessage = myConfig.Name + " is Already Decommissioning."
+		decommissionResponse.Code = uint32(codes.Aborted)
+		return decommissionResponse
+	}
+	decommission.started = true
+	decommission.mtx.Unlock()
+
+	gossipTable.SetStatus(cassandra.EndpointState_LEAVING, nil)
+	pendingReplicas.Leave(myConfig.Name)
+	fmt.Println("Decommission: LEAVING - Waiting", bootstrapRingDelay*gossipInterval, "for the Ring to Settle")
+	time.Sleep(bootstrapRingDelay * gossipInterval)
+
+	//The Ring Without this Replica
+	futureRing := tokenRing.Clone()
+	futureRing.RemoveReplica(myConfig.Name)
+
+	transfers, rowsSent, failures := 0, 0, 0
+	for _, keyspace := range KeyspaceConfig.Names() {
+
+		strategy := KeyspaceConfig.Strategy(keyspace)
+
+		for _, tokenRange := range tokenRing.Ranges(strategy) {
+
+			if !slices.Contains(tokenRange.Replicas, myConfig.Name) {
+				continue
+			}
+
+			//The Next Ring Has Fewer Tokens, so the Whole Range Goes to the Next Owners of its End
+			for _, replicaName := range futureRing.TokenPreferenceList(tokenRange.End, strategy) {
+
+				//Current Owners Already Hold the Range
+				if slices.Contains(tokenRange.Replicas, replicaName) {
+					continue
+				}
+
+				sent, err := StreamRangeTo(replicaName, keyspace, tokenRange)
+				if err != nil {
+					fmt.Println("Decommission: Streaming to", replicaName, "Failed.", "Keyspace:", keyspace, err)
+					failures++
+					continue
+				}
+
+				transfers++
+				rowsSent += sent
+
+			}
+
+		}
+
+	}
+
+	if failures > 0 {
+
+		gossipTable.SetStatus(cassandra.EndpointState_NORMAL, nil)
+		pendingReplicas.Remove(myConfig.Name)
+
+		decommission.mtx.Lock()
+		decommission.started = false
+		decommission.mtx.Unlock()
+
+		decommissionResponse.RespMessage = fmt.Sprintf("Decommission Aborted: %d Range Transfers Failed. %s Stays in the Cluster.",
+			failures, myConfig.Name)
+		decommissionResponse.Code = uint32(codes.Unavailable)
+		fmt.Println(decommissionResponse.RespMessage)
+		return decommissionResponse
+	}
+
+	//Hints this Replica Holds Would Leave with it
+	for _, target := range hintStore.Targets() {
+		DeliverHints(target)
+	}
+
+	gossipTable.SetStatus(cassandra.EndpointState_LEFT, nil)
+
+	decommissionResponse.Status = true
+	decommissionResponse.RespMessage = fmt.Sprintf("Decommission: %d Range Transfers, %d Rows Sent. %s Has Left the Cluster.",
+		transfers, rowsSent, myConfig.Name)
+	fmt.Println(decommissionResponse.RespMessage)
+
+	//Keep Gossiping Long Enough for LEFT to Spread, then Exit
+	go func() {
+		time.Sleep(bootstrapRingDelay * gossipInterval)
+		fmt.Println("Replica Closing...")
+		if err := KeyValueConfig.Close(); err != nil {
+			fmt.Println("Storage Engine Error:", err)
+		}
+		os.Exit(0)
+	}()
+
+	return decommissionResponse
+}
+
+//---------------------------------------------------------------------------//
+
+//Why the Replicas Left Without this One Could Not Hold Some Keyspace - Overall, or in a
+//Datacenter for NetworkTopologyStrategy; Empty if they Can
+func DecommissionShortfall() string {
+
+	futureRing := tokenRing.Clone()
+	futureRing.RemoveReplica(myConfig.Name)
+
+	remaining := len(futureRing.Replicas())
+	remainingPerDatacenter := futureRing.DatacenterReplicas()
+
+	for _, keyspace := range KeyspaceConfig.Names() {
+
+		settings := KeyspaceConfig.Settings(keyspace)
+
+		if len(settings.Datacenters) == 0 && settings.ReplicationFactor > remaining {
+			return fmt.Sprintf("Keyspace %s Needs %d Replicas; Only %d Would Remain.", keyspace, settings.ReplicationFactor, remaining)
+		}
+
+		for datacenter, datacenterReplicas := range settings.Datacenters {
+			if datacenterReplicas > remainingPerDatacenter[datacenter] {
+				return fmt.Sprintf("Keyspace %s Needs %d Replicas in %s; Only %d Would Remain.",
+					keyspace, datacenterReplicas, datacenter, remainingPerDatacenter[datacenter])
+			}
+		}
+
+	}
+
+	return ""
+}
+
+//---------------------------------------------------------------------------//
+
+//Send Every Row of the Range this Replica Holds to a Next Owner; Returns the Rows Sent
+func StreamRangeTo(replicaName string, keyspace string, tokenRange Ring.Range) (int, error) {
+
+	eachReplica, isPeer := myReplicaCluster.Get(replicaName)
+	if !isPeer {
+		return 0, errors.New("Replica " + replicaName + " is Not Known")
+	}
+
+	//Rows are Sent as the Engine Scan Reaches them - the Range is Never Held in Memory
+	sent := 0
+	var sendErr error
+	err := KeyValueConfig.ScanRows(keyspace, nil, nil, func(key []byte, keyValues keyConfig) bool {
+
+		if !Merkle.InRange(Ring.KeyToken(key), tokenRange.Start, tokenRange.End) {
+			return true
+		}
+
+		replicaPutMessage := new(cassandra.InputRequest_ReplicaPut)
+		replicaPutMessage.ReplicaPut = new(cassandra.ReplicaPut)
+		replicaPutMessage.ReplicaPut.Input = RangeRow(keyspace, key, keyValues)
+
+		replicaMsg := new(cassandra.InputRequest)
+		replicaMsg.InputRequest = replicaPutMessage
+
+		respMsg, err := eachReplica.Pool.Call(replicaMsg, replicaRequestTimeout)
+		if err == nil && !respMsg.GetResponse().GetStatus() {
+			err = errors.New(respMsg.GetResponse().GetRespMessage())
+		}
+		if err != nil {
+			sendErr = err
+			return false
+		}
+		sent++
+
+		return true
+	})
+	if err == nil {
+		err = sendErr
+	}
+	if err != nil {
+		return sent, err
+	}
+
+	if sent > 0 {
+		fmt.Println("Decommission: Streamed", sent, "Rows to", replicaName, "Keyspace:", keyspace)
+	}
+
+	return sent, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Repair One Range with One Replica: Compare Merkle Trees, then Swap the Rows of the Differing Leaves;
+//Each Side Keeps the Newer Version. Returns the Rows Received and Sent
+func RepairRange(eachReplica replica, keyspace string, localTree *Merkle.Tree, storageWriter *Storage.CommitLog) (int, int, error) {
+
+	//Ask the Replica for its Tree
+	treeMessage := new(cassandra.InputRequest_MerkleTreeRequest)
+	treeMessage.MerkleTreeRequest = new(cassandra.MerkleTreeRequest)
+	treeMessage.MerkleTreeRequest.Keyspace = keyspace
+	treeMessage.MerkleTreeRequest.Range = &cassandra.TokenRange{Start: localTree.Start, End: localTree.End}
+	treeMessage.MerkleTreeRequest.Depth = uint32(localTree.Depth)
+
+	replicaMsg := new(cassandra.InputRequest)
+	replicaMsg.InputRequest = treeMessage
+
+	respMsg, err := eachReplica.Pool.Call(replicaMsg, replicaRequestTimeout)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	treeMsg := respMsg.GetMerkleTree()
+	if treeMsg == nil {
+		return 0, 0, errors.New(respMsg.GetResponse().GetRespMessage())
+	}
+
+	replicaTree := new(Merkle.Tree)
+	replicaTree.Start = treeMsg.GetRange().GetStart()
+	replicaTree.End = treeMsg.GetRange().GetEnd()
+	replicaTree.Depth = int(treeMsg.GetDepth())
+	replicaTree.Hashes = treeMsg.GetHashes()
+
+	leaves, err := Merkle.Difference(localTree, replicaTree)
+	if err != nil || len(leaves) == 0 {
+		return 0, 0, err
+	}
+
+	//Fetch the Replica's Rows in the Differing Leaves
+	rowsMessage := new(cassandra.InputRequest_RepairRowsRequest)
+	rowsMessage.RepairRowsRequest = new(cassandra.RepairRowsRequest)
+	rowsMessage.RepairRowsRequest.Keyspace = keyspace
+	rowsMessage.RepairRowsRequest.Range = treeMessage.MerkleTreeRequest.Range
+	rowsMessage.RepairRowsRequest.Depth = uint32(localTree.Depth)
+	for _, leaf := range leaves {
+		rowsMessage.RepairRowsRequest.Leaves = append(rowsMessage.RepairRowsRequest.Leaves, uint32(leaf))
+	}
+
+	replicaMsg = new(cassandra.InputRequest)
+	replicaMsg.InputRequest = rowsMessage
+
+	respMsg, err = eachReplica.Pool.Call(replicaMsg, replicaRequestTimeout)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	rowsMsg := respMsg.GetRepairRows()
+	if rowsMsg == nil {
+		return 0, 0, errors.New(respMsg.GetResponse().GetRespMessage())
+	}
+
+	localRows, err := KeyspaceLeafRows(keyspace, localTree, leaves)
+	if err != nil {
+		return 0, 0, err
+	}
+	replicaRows := make(map[string]*cassandra.RequestParameter)
+
+	//Keep the Replica's Rows that are Newer than Ours - Both Sides Break Equal Timestamps Alike
+	received := 0
+	for _, row := range rowsMsg.GetRows() {
+
+		replicaRows[string(row.GetKey())] = row
+
+		if localRow, found := localRows[string(row.GetKey())]; found && !RepairRowNewer(row, localRow) {
+			continue
+		}
+
+		row.Keyspace = keyspace
+		if err := WriteToStorage(row, storageWriter); err != nil {
+			return received, 0, err
+		}
+		received++
+
+		fmt.Println("Repair: Received from", eachReplica.Name, "Keyspace:", keyspace, "Key:", string(row.GetKey()),
+			"Value:", row.GetValue(), "Time:", row.GetTimeInSeconds())
+	}
+
+	//Send Ours Where the Replica's is Older or Missing
+	sent := 0
+	for key, localRow := range localRows {
+
+		if replicaRow, found := replicaRows[key]; found && !RepairRowNewer(localRow, replicaRow) {
+			continue
+		}
+
+		replicaPutMessage := new(cassandra.InputRequest_ReplicaPut)
+		replicaPutMessage.ReplicaPut = new(cassandra.ReplicaPut)
+		replicaPutMessage.ReplicaPut.Input = localRow
+
+		replicaMsg = new(cassandra.InputRequest)
+		replicaMsg.InputRequest = replicaPutMessage
+
+		respMsg, err = eachReplica.Pool.Call(replicaMsg, replicaRequestTimeout)
+		if err == nil && !respMsg.GetResponse().GetStatus() {
+			err = errors.New(respMsg.GetResponse().GetRespMessage())
+		}
+		if err != nil {
+			return received, sent, err
+		}
+		sent++
+
+		fmt.Println("Repair: Sent to", eachReplica.Name, "Keyspace:", keyspace, "Key:", key,
+			"Value:", localRow.GetValue(), "Time:", localRow.GetTimeInSeconds())
+	}
+
+	return received, sent, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//True if Row a Supersedes Row b, by the Rule the Storage Engine Keeps Versions by
+func RepairRowNewer(a *cassandra.RequestParameter, b *cassandra.RequestParameter) bool {
+
+	rowA := Storage.Row{Value: a.GetValue(), Timestamp: a.GetTimeInSeconds()}
+	rowB := Storage.Row{Value: b.GetValue(), Timestamp: b.GetTimeInSeconds()}
+
+	return rowA.Newer(rowB)
+}
+
+//---------------------------------------------------------------------------//
+
+//Merkle Tree over this Replica's Rows of the Keyspace in the Token Range
+func KeyspaceMerkleTree(keyspace string, start int64, end int64, depth int) (*Merkle.Tree, error) {
+
+	trees, err := KeyspaceMerkleTrees(keyspace, []Ring.Range{{Start: start, End: end}}, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return trees[0], nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Merkle Trees over this Replica's Rows of the Keyspace, One per Token Range, Built in One Pass over
+//the Storage Engine - Rows are Streamed, Never Held All at Once
+func KeyspaceMerkleTrees(keyspace string, tokenRanges []Ring.Range, depth int) ([]*Merkle.Tree, error) {
+
+	trees := []*Merkle.Tree{}
+	for _, tokenRange := range tokenRanges {
+		trees = append(trees, Merkle.New(tokenRange.Start, tokenRange.End, depth))
+	}
+
+	err := KeyValueConfig.ScanRows(keyspace, nil, nil, func(key []byte, keyValues keyConfig) bool {
+
+		token := Ring.KeyToken(key)
+		for _, tree := range trees {
+			if Merkle.InRange(token, tree.Start, tree.End) {
+				tree.Add(token, key, ValueDigest(keyValues.MyValue, keyValues.Arrived))
+			}
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tree := range trees {
+		tree.Build()
+	}
+
+	return trees, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//This Replica's Rows of the Keyspace that Fall in the Given Leaves of the Tree
+func KeyspaceLeafRows(keyspace string, tree *Merkle.Tree, leaves []int) (map[string]*cassandra.RequestParameter, error) {
+
+	wanted := make(map[int]bool)
+	for _, leaf := range leaves {
+		wanted[leaf] = true
+	}
+
+	//Only the Rows of the Wanted Leaves are Kept
+	rows := make(map[string]*cassandra.RequestParameter)
+	err := KeyValueConfig.ScanRows(keyspace, nil, nil, func(key []byte, keyValues keyConfig) bool {
+
+		token := Ring.KeyToken(key)
+		if !Merkle.InRange(token, tree.Start, tree.End) || !wanted[tree.Leaf(token)] {
+			return true
+		}
+
+		rows[string(key)] = RangeRow(keyspace, key, keyValues)
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//A Stored Row as Sent to Another Replica
+func RangeRow(keyspace string, key []byte, keyValues keyConfig) *cassandra.RequestParameter {
+
+	row := new(cassandra.RequestParameter)
+	row.OriginReplica = myConfig.Name
+	row.Keyspace = keyspace
+	row.Key = slices.Clone(key)
+	row.Value = keyValues.MyValue
+	row.TimeInSeconds = keyValues.Arrived
+
+	return row
+}
+
+//---------------------------------------------------------------------------//
+
+//Replica: Send the Merkle Tree of a Range to the Repairing Replica
+func SendMerkleTree(treeReqMsg *cassandra.MerkleTreeRequest, replicaSocket responder) {
+
+	keyspace := KeyspaceName(treeReqMsg.GetKeyspace())
+	if !KeyspaceConfig.Exists(keyspace) {
+		UnknownKeyspaceMsg(nil, keyspace, replicaSocket)
+		return
+	}
+
+	tree, err := KeyspaceMerkleTree(keyspace, treeReqMsg.GetRange().GetStart(), treeReqMsg.GetRange().GetEnd(), int(treeReqMsg.GetDepth()))
+	if err != nil {
+		StorageErrorMsg(nil, err, replicaSocket)
+		return
+	}
+
+	treeMessage := new(cassandra.InputRequest_MerkleTree)
+	treeMessage.MerkleTree = new(cassandra.MerkleTree)
+	treeMessage.MerkleTree.Range = &cassandra.TokenRange{Start: tree.Start, End: tree.End}
+	treeMessage.MerkleTree.Depth = uint32(tree.Depth)
+	treeMessage.MerkleTree.Hashes = tree.Hashes
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = treeMessage
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Merkle Tree: Error while sending response.!", err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Replica: Send the Rows in the Differing Leaves to the Repairing Replica
+func SendRepairRows(rowsReqMsg *cassandra.RepairRowsRequest, replicaSocket responder) {
+
+	keyspace := KeyspaceName(rowsReqMsg.GetKeyspace())
+	if !KeyspaceConfig.Exists(keyspace) {
+		UnknownKeyspaceMsg(nil, keyspace, replicaSocket)
+		return
+	}
+
+	tree := Merkle.New(rowsReqMsg.GetRange().GetStart(), rowsReqMsg.GetRange().GetEnd(), int(rowsReqMsg.GetDepth()))
+
+	leaves := []int{}
+	for _, leaf := range rowsReqMsg.GetLeaves() {
+		leaves = append(leaves, int(leaf))
+	}
+
+	leafRows, err := KeyspaceLeafRows(keyspace, tree, leaves)
+	if err != nil {
+		StorageErrorMsg(nil, err, replicaSocket)
+		return
+	}
+
+	rowsMessage := new(cassandra.InputRequest_RepairRows)
+	rowsMessage.RepairRows = new(cassandra.RepairRows)
+	for _, row := range leafRows {
+		rowsMessage.RepairRows.Rows = append(rowsMessage.RepairRows.Rows, row)
+	}
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = rowsMessage
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Repair Rows: Error while sending response.!", err)
+	}
+
+	fmt.Println("Repair Rows:", "Keyspace:", keyspace, "Leaves:", len(leaves), "Rows:", len(rowsMessage.RepairRows.Rows))
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Replica: Send Every Keyspace Definition to a Bootstrapping Replica
+func SendSchema(replicaSocket responder) {
+
+	schemaMessage := new(cassandra.InputRequest_Schema)
+	schemaMessage.Schema = new(cassandra.Schema)
+
+	for _, keyspace := range KeyspaceConfig.Names() {
+
+		settings := KeyspaceConfig.Settings(keyspace)
+
+		keyspaceMsg := new(cassandra.KeyspaceDefinition)
+		keyspaceMsg.Name = keyspace
+		keyspaceMsg.ReplicationFactor = uint32(settings.ReplicationFactor)
+		keyspaceMsg.ReadRepairChance = settings.ReadRepairChance
+		keyspaceMsg.HintedHandoff = settings.HintedHandOff
+		for datacenter, datacenterReplicas := range settings.Datacenters {
+			if keyspaceMsg.DatacenterReplication == nil {
+				keyspaceMsg.DatacenterReplication = make(map[string]uint32)
+			}
+			keyspaceMsg.DatacenterReplication[datacenter] = uint32(datacenterReplicas)
+		}
+
+		schemaMessage.Schema.Keyspaces = append(schemaMessage.Schema.Keyspaces, keyspaceMsg)
+
+	}
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = schemaMessage
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Schema: Error while sending response.!", err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func SendResponseToClient(keyspace string, key []byte, replicaSocket responder) {
+
+	replicaResponse := new(cassandra.InputRequest_Response)
+	replicaResponse.Response = new(cassandra.Response)
+	replicaResponse.Response.Key = key
+	replicaResponse.Response.OriginReplica = myConfig.Name
+	replicaResponse.Response.Status = true
+	replicaResponse.Response.RespMessage = "Key-Value Pair is Successfully Stored..!"
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = replicaResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Client PUT: Error while sending response.!", err)
+	}
+
+	keyValues, _ := KeyValueConfig.ReadValue(keyspace, key)
+	fmt.Println("Client PUT:", "Keyspace:", keyspace, "Key:", string(key), "Value:", keyValues.MyValue, "Time:", keyValues.Arrived)
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Reply to the Coordinator Once a ReplicaPut is Written (or Could Not be)
+func ReplicaPutAck(key []byte, err error, replicaSocket responder) {
+
+	replicaResponse := new(cassandra.InputRequest_Response)
+	replicaResponse.Response = new(cassandra.Response)
+	replicaResponse.Response.Key = key
+	replicaResponse.Response.OriginReplica = myConfig.Name
+	replicaResponse.Response.Status = true
+	replicaResponse.Response.RespMessage = myConfig.Name + "Success"
+
+	if err != nil {
+		replicaResponse.Response.Status = false
+		replicaResponse.Response.RespMessage = "Replica PUT Failed: " + err.Error()
+		replicaResponse.Response.Code = uint32(codes.Internal)
+	}
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = replicaResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Replica PUT: Error while sending acknowledgement.!", err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func MakeHints(replicaName string, clientPutMsg *cassandra.ClientPut) error {
+
+	// Make Hints for Hinted-HandsOff
+	newHint := new(cassandra.Hint)
+	newHint.TargetReplica = replicaName
+	newHint.Keyspace = clientPutMsg.Input.GetKeyspace()
+	newHint.Key = clientPutMsg.Input.GetKey()
+	newHint.Value = clientPutMsg.Input.GetValue()
+	newHint.TimeInSeconds = clientPutMsg.Input.GetTimeInSeconds()
+	newHint.Created = time.Now().Unix()
+
+	//Written to the Hint Log Before it Counts
+	if err := hintStore.Add(newHint); err != nil {
+		fmt.Println("Hint Not Logged:", "Replica:", replicaName, "Key:", string(newHint.Key), err)
+		return err
+	}
+
+	fmt.Println("Hint Logged:", "Replica:", replicaName, "Key:", string(newHint.Key), "Value:", newHint.Value, "Time:", newHint.TimeInSeconds)
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+func CheckReplicaStatus(keyspace string, key []byte, consistency string) bool {
+
+	//ANY Needs No Owner UP - the Coordinator Can Always Keep a Hint
+	if consistency == consistencyAny {
+		return true
+	}
+
+	//Replicas that Must be UP, Derived from the Keyspace's Replication Factor (per Datacenter for LOCAL_QUORUM and EACH_QUORUM)
+	owners := KeyReplicas(keyspace, key)
+	quorum := NewReplicaQuorum(consistency, KeyspaceConfig.Strategy(keyspace), owners)
+
+	//Am I the coordinator.?
+	if slices.Contains(owners, myConfig.Name) {
+		quorum.Add(myConfig.Name)
+
+		if quorum.Met() {
+			return true
+		}
+
+	}
+
+	//Check Other Replicas
+	for _, replicaName := range owners {
+
+		if _, isPeer := myReplicaCluster.Get(replicaName); isPeer {
+
+			//Replica UP According to the Failure Detector - No Probe on the Request Path
+			if failureDetector.Alive(replicaName) {
+				quorum.Add(replicaName)
+			}
+
+			if quorum.Met() {
+				return true
+			}
+
+		}
+	}
+
+	//Not Enough Replicas are UP
+	return false
+
+}
+
+//---------------------------------------------------------------------------//
+
+func KeyBelongsToMe(keyspace string, key []byte) bool {
+
+	for _, replicaName := range KeyReplicas(keyspace, key) {
+		if replicaName == myConfig.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+//---------------------------------------------------------------------------//
+
+//Preference List of the Key - Its Replicas in Ring Order, as Many as the Keyspace's Replication Factor
+func KeyReplicas(keyspace string, key []byte) []string {
+	return tokenRing.PreferenceList(key, KeyspaceConfig.Strategy(keyspace))
+}
+
+//---------------------------------------------------------------------------//
+
+//Replicas that Must Respond at a Consistency Level
+func RequiredReplicas(consistency string, replicationFactor int) int {
+
+	switch consistency {
+	case consistencyTwo:
+		return 2
+	case consistencyThree:
+		return 3
+	case consistencyQuorum:
+		return replicationFactor/2 + 1
+	case consistencyAll:
+		return replicationFactor
+	}
+
+	//ONE, and ANY - where a Stored Hint Also Counts
+	return constOne
+}
+
+//---------------------------------------------------------------------------//
+
+//Replicas Needed at a Consistency Level for a Key Held by owners. LOCAL_QUORUM Needs a Quorum of the
+//Coordinator's Datacenter Only, EACH_QUORUM a Quorum of Every Datacenter; Each Quorum is Taken over the
+//Datacenter's Replication Factor (NetworkTopologyStrategy) or its Share of the Owners (SimpleStrategy)
+func NewReplicaQuorum(consistency string, strategy Ring.Strategy, owners []string) *replicaQuorum {
+
+	quorum := new(replicaQuorum)
+	quorum.Required = make(map[string]int)
+	quorum.Counted = make(map[string]int)
+
+	if consistency != consistencyLocalQuorum && consistency != consistencyEachQuorum {
+		quorum.Required[""] = RequiredReplicas(consistency, strategy.ReplicationFactor)
+		return quorum
+	}
+
+	datacenterReplicas := make(map[string]int)
+	if len(strategy.Datacenters) > 0 {
+		maps.Copy(datacenterReplicas, strategy.Datacenters)
+	} else {
+		for _, replicaName := range owners {
+			datacenterReplicas[tokenRing.Location(replicaName).Datacenter]++
+		}
+	}
+
+	if consistency == consistencyLocalQuorum {
+		localDatacenter := tokenRing.Location(myConfig.Name).Datacenter
+		datacenterReplicas = map[string]int{localDatacenter: datacenterReplicas[localDatacenter]}
+	}
+
+	//A Datacenter Holding No Replica of the Keyspace Can Never Make its Quorum
+	for datacenter, replicas := range datacenterReplicas {
+		quorum.Required[datacenter] = replicas/2 + 1
+	}
+
+	return quorum
+}
+
+//---------------------------------------------------------------------------//
+
+//True if the Replica's Response Counts Toward the Consistency Level
+func (q *replicaQuorum) Counts(replicaName string) bool {
+
+	if _, total := q.Required[""]; total {
+		return true
+	}
+
+	_, counts := q.Required[tokenRing.Location(replicaName).Datacenter]
+
+	return counts
+}
+
+//---------------------------------------------------------------------------//
+
+func (q *replicaQuorum) Add(replicaName string) {
+
+	if _, total := q.Required[""]; total {
+		q.Counted[""]++
+		return
+	}
+
+	datacenter := tokenRing.Location(replicaName).Datacenter
+	if _, counts := q.Required[datacenter]; counts {
+		q.Counted[datacenter]++
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func (q *replicaQuorum) Met() bool {
+
+	for datacenter, required := range q.Required {
+		if q.Counted[datacenter] < required {
+			return false
+		}
+	}
+
+	return true
+}
+
+//---------------------------------------------------------------------------//
+
+//"1 of the 2 Required Replicas", or per Datacenter "1 of the 2 Required Replicas in dc1, 0 of the 2 in dc2"
+func (q *replicaQuorum) Progress() string {
+
+	if required, total := q.Required[""]; total {
+		return fmt.Sprintf("%d of the %d Required Replicas", q.Counted[""], required)
+	}
+
+	datacenters := slices.Sorted(maps.Keys(q.Required))
+
+	entries := []string{}
+	for i, datacenter := range datacenters {
+		if i == 0 {
+			entries = append(entries, fmt.Sprintf("%d of the %d Required Replicas in %s", q.Counted[datacenter], q.Required[datacenter], datacenter))
+		} else {
+			entries = append(entries, fmt.Sprintf("%d of the %d in %s", q.Counted[datacenter], q.Required[datacenter], datacenter))
+		}
+	}
+
+	return strings.Join(entries, ", ")
+}
+
+//---------------------------------------------------------------------------//
+
+func NotEnoughReplicaMsg(key []byte, replicaSocket responder) {
+
+	//Send Response to Client
+	replicaResponse := new(cassandra.InputRequest_Response)
+	replicaResponse.Response = new(cassandra.Response)
+	replicaResponse.Response.Key = key
+	replicaResponse.Response.OriginReplica = myConfig.Name
+	replicaResponse.Response.Status = false
+	replicaResponse.Response.RespMessage = "Cannot Process This Request. Not Enough Replicas are UP for this request.!"
+	replicaResponse.Response.Code = uint32(codes.Unavailable)
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = replicaResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Replica Exception: Error while sending response.!", err)
+	}
+
+	fmt.Println("Replica Exception: Not Enough Replicas are UP...!!! ")
+
+}
+
+//---------------------------------------------------------------------------//
+
+//The Storage Engine Failed a Read - the Requester Gets Internal Rather than an Empty Value
+func StorageErrorMsg(key []byte, err error, replicaSocket responder) {
+
+	replicaResponse := new(cassandra.InputRequest_Response)
+	replicaResponse.Response = new(cassandra.Response)
+	replicaResponse.Response.Key = key
+	replicaResponse.Response.OriginReplica = myConfig.Name
+	replicaResponse.Response.Status = false
+	replicaResponse.Response.RespMessage = "Storage Engine Error: " + err.Error()
+	replicaResponse.Response.Code = uint32(codes.Internal)
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = replicaResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Replica Exception: Error while sending response.!", err)
+	}
+
+	fmt.Println("Replica Exception:", replicaResponse.Response.RespMessage)
+
+}
+
+//---------------------------------------------------------------------------//
+
+func WriteFailedMsg(key []byte, quorum *replicaQuorum, replicaSocket responder) {
+
+	//Send Response to Client
+	replicaResponse := new(cassandra.InputRequest_Response)
+	replicaResponse.Response = new(cassandra.Response)
+	replicaResponse.Response.Key = key
+	replicaResponse.Response.OriginReplica = myConfig.Name
+	replicaResponse.Response.Status = false
+	replicaResponse.Response.RespMessage = "PUT Failed. Only " + quorum.Progress() + " Acknowledged the Write.!"
+	replicaResponse.Response.Code = uint32(codes.Unavailable)
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = replicaResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Replica Exception: Error while sending response.!", err)
+	}
+
+	fmt.Println("Replica Exception:", replicaResponse.Response.RespMessage)
+
+}
+
+//---------------------------------------------------------------------------//
+
+func ReadFailedMsg(key []byte, quorum *replicaQuorum, replicaSocket responder) {
+
+	//Send Response to Client
+	replicaResponse := new(cassandra.InputRequest_Response)
+	replicaResponse.Response = new(cassandra.Response)
+	replicaResponse.Response.Key = key
+	replicaResponse.Response.OriginReplica = myConfig.Name
+	replicaResponse.Response.Status = false
+	replicaResponse.Response.RespMessage = "GET Failed. Only " + quorum.Progress() + " Responded.!"
+	replicaResponse.Response.Code = uint32(codes.Unavailable)
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = replicaResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Replica Exception: Error while sending response.!", err)
+	}
+
+	fmt.Println("Replica Exception:", replicaResponse.Response.RespMessage)
+
+}
+
+//---------------------------------------------------------------------------//
+
+func InvalidKeyMsg(replicaSocket responder) {
+
+	//Send Response to Client
+	replicaResponse := new(cassandra.InputRequest_Response)
+	replicaResponse.Response = new(cassandra.Response)
+	replicaResponse.Response.OriginReplica = myConfig.Name
+	replicaResponse.Response.Status = false
+	replicaResponse.Response.RespMessage = "Cannot Process This Request. KEY must not be empty.!"
+	replicaResponse.Response.Code = uint32(codes.InvalidArgument)
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = replicaResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Replica Exception: Error while sending response.!", err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func UnknownKeyspaceMsg(key []byte, keyspace string, replicaSocket responder) {
+
+	//Send Response to Client
+	replicaResponse := new(cassandra.InputRequest_Response)
+	replicaResponse.Response = new(cassandra.Response)
+	replicaResponse.Response.Key = key
+	replicaResponse.Response.OriginReplica = myConfig.Name
+	replicaResponse.Response.Status = false
+	replicaResponse.Response.RespMessage = "Cannot Process This Request. Keyspace " + keyspace + " Does Not Exist.!"
+	replicaResponse.Response.Code = uint32(codes.NotFound)
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = replicaResponse
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Replica Exception: Error while sending response.!", err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//In-Memory Map Key; Keyspace Names Never Contain NUL, so Keyspaces Cannot Collide
+func StorageKey(keyspace string, key []byte) string {
+	return keyspace + "\x00" + string(key)
+}
+
+//---------------------------------------------------------------------------//
+
+func (cs *criticalSection) UpdateValue(keyspace string, keyVal []byte, value string, timeValLatest int64) error {
+
+	//The Engine Keeps the Value Only if it is Newer than the Stored One
+	return cs.Engine.Put([]byte(StorageKey(keyspace, keyVal)), Storage.Row{Value: value, Timestamp: timeValLatest})
+}
+
+//---------------------------------------------------------------------------//
+
+func (cs *criticalSection) ReadValue(keyspace string, keyVal []byte) (keyConfig, error) {
+
+	row, err := cs.Engine.Get([]byte(StorageKey(keyspace, keyVal)))
+	if err != nil {
+		return keyConfig{}, err
+	}
+
+	return keyConfig{MyValue: row.Value, Arrived: row.Timestamp}, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Visit the Keyspace's Rows from startKey to endKey (Both Included; an Empty endKey Runs to the End of the Keyspace)
+//in Byte Order, Until visit Returns False
+func (cs *criticalSection) ScanRows(keyspace string, startKey []byte, endKey []byte, visit func(key []byte, keyValues keyConfig) bool) error {
+
+	keyPrefix := StorageKey(keyspace, nil)
+
+	//Keys Just Past endKey, or Past the Whole Keyspace - Keyspace Names Never Contain NUL
+	end := []byte(keyspace + "\x01")
+	if len(endKey) > 0 {
+		end = []byte(StorageKey(keyspace, endKey) + "\x00")
+	}
+
+	return cs.Engine.Scan([]byte(StorageKey(keyspace, startKey)), end, func(key []byte, row Storage.Row) bool {
+		return visit(key[len(keyPrefix):], keyConfig{MyValue: row.Value, Arrived: row.Timestamp})
+	})
+}
+
+//---------------------------------------------------------------------------//
+
+func (cs *criticalSection) Close() error {
+
+	if err := cs.Engine.Flush(); err != nil {
+		return err
+	}
+	if err := cs.Engine.Close(); err != nil {
+		return err
+	}
+
+	//Syncs Whatever the Sync Mode Has Not Yet
+	if cs.Log != nil {
+		return cs.Log.Close()
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Requests Without a Keyspace Use the Default Keyspace
+func KeyspaceName(keyspace string) string {
+
+	if keyspace == "" {
+		return defaultKeyspace
+	}
+
+	return keyspace
+}
+
+//---------------------------------------------------------------------------//
+
+//Load the Keyspaces Defined Before a Reboot; Each Line is name@#replicationFactor@#readRepairChance@#hintedHandOff
+//(Lines Written Before the Repair Settings Existed Carry Only name@#replicationFactor)
+func (kt *keyspaceTable) Load(fileName string) {
+
+	kt.mtx.Lock()
+	defer kt.mtx.Unlock()
+
+	kt.fileName = fileName
+	kt.Keyspaces = make(map[string]keyspaceSettings)
+	kt.Keyspaces[defaultKeyspace] = DefaultKeyspaceSettings()
+
+	fileId, err := os.Open(fileName)
+
+	//No Keyspaces Defined Yet
+	if err != nil {
+		return
+	}
+	defer fileId.Close()
+
+	fileBuf := bufio.NewReader(fileId)
+	fileContent, _, err := fileBuf.ReadLine()
+
+	for err == nil {
+
+		data := strings.Split(string(fileContent), separator)
+		if len(data) == 2 || len(data) == 4 || len(data) == 5 {
+
+			settings := DefaultKeyspaceSettings()
+			replicationFactor, convErr := strconv.Atoi(data[1])
+			settings.ReplicationFactor = replicationFactor
+
+			if convErr == nil && len(data) >= 4 {
+				settings.ReadRepairChance, convErr = strconv.ParseFloat(data[2], 64)
+				settings.HintedHandOff = data[3] == yes
+			}
+
+			//NetworkTopologyStrategy Keyspaces Carry their Datacenters Last
+			if convErr == nil && len(data) == 5 {
+				settings.Datacenters, convErr = Ring.ParseDatacenters(data[4])
+			}
+
+			if convErr == nil {
+				kt.Keyspaces[data[0]] = settings
+			}
+		}
+
+		fileContent, _, err = fileBuf.ReadLine()
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Add a Keyspace; Re-Defining it with the Same Settings is Not an Error
+func (kt *keyspaceTable) Define(keyspace string, settings keyspaceSettings) error {
+
+	if !ValidKeyspaceName(keyspace) {
+		return status.Error(codes.InvalidArgument, "Keyspace Name Must be 1-"+strconv.Itoa(maxKeyspaceNameLength)+" Letters, Digits or Underscores.")
+	}
+
+	if settings.ReplicationFactor < constOne {
+		return status.Error(codes.InvalidArgument, "Replication Factor Must be at Least 1.")
+	}
+
+	for datacenter, datacenterReplicas := range settings.Datacenters {
+		if datacenter == "" || datacenterReplicas < constOne {
+			return status.Error(codes.InvalidArgument, "Every Datacenter Needs a Name and at Least 1 Replica.")
+		}
+	}
+
+	if settings.ReadRepairChance < 0 || settings.ReadRepairChance > 1 {
+		return status.Error(codes.InvalidArgument, "Read Repair Chance Must be Between 0 and 1.")
+	}
+
+	kt.mtx.Lock()
+	defer kt.mtx.Unlock()
+
+	if current, found := kt.Keyspaces[keyspace]; found {
+
+		if current.Strategy().String() != settings.Strategy().String() {
+			return status.Error(codes.AlreadyExists, "Keyspace "+keyspace+" Already Exists with "+current.Strategy().String()+".")
+		}
+
+		if current.ReadRepairChance != settings.ReadRepairChance || current.HintedHandOff != settings.HintedHandOff {
+			return status.Error(codes.AlreadyExists, "Keyspace "+keyspace+" Already Exists with Different Repair Settings.")
+		}
+
+		return nil
+	}
+
+	hintedHandOff := "0"
+	if settings.HintedHandOff {
+		hintedHandOff = yes
+	}
+
+	//Persist Before Use, so the Keyspace is Known After a Reboot
+	fileId, err := os.OpenFile(kt.fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer fileId.Close()
+
+	keyspaceLine := keyspace + separator + strconv.Itoa(settings.ReplicationFactor) + separator +
+		strconv.FormatFloat(settings.ReadRepairChance, 'g', -1, 64) + separator + hintedHandOff
+	if len(settings.Datacenters) > 0 {
+		keyspaceLine += separator + Ring.FormatDatacenters(settings.Datacenters)
+	}
+	keyspaceLine += "\n"
+
+	if _, err := fileId.WriteString(keyspaceLine); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	kt.Keyspaces[keyspace] = settings
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Every Keyspace, Sorted
+func (kt *keyspaceTable) Names() []string {
+
+	kt.mtx.Lock()
+	defer kt.mtx.Unlock()
+
+	keyspaces := make([]string, 0, len(kt.Keyspaces))
+	for keyspace := range kt.Keyspaces {
+		keyspaces = append(keyspaces, keyspace)
+	}
+	sort.Strings(keyspaces)
+
+	return keyspaces
+}
+
+//---------------------------------------------------------------------------//
+
+func (kt *keyspaceTable) Exists(keyspace string) bool {
+
+	kt.mtx.Lock()
+	defer kt.mtx.Unlock()
+
+	_, found := kt.Keyspaces[keyspace]
+
+	return found
+}
+
+//---------------------------------------------------------------------------//
+
+//Replication Factor of the Keyspace; Unknown Keyspaces Get the Default
+func (kt *keyspaceTable) Get(keyspace string) int {
+	return kt.Settings(keyspace).ReplicationFactor
+}
+
+//---------------------------------------------------------------------------//
+
+//Replica Placement of the Keyspace; Unknown Keyspaces Get the Default
+func (kt *keyspaceTable) Strategy(keyspace string) Ring.Strategy {
+	return kt.Settings(keyspace).Strategy()
+}
+
+//---------------------------------------------------------------------------//
+
+//Settings of the Keyspace; Unknown Keyspaces Get the Defaults
+func (kt *keyspaceTable) Settings(keyspace string) keyspaceSettings {
+
+	kt.mtx.Lock()
+	defer kt.mtx.Unlock()
+
+	if settings, found := kt.Keyspaces[keyspace]; found {
+		return settings
+	}
+
+	return DefaultKeyspaceSettings()
+}
+
+//---------------------------------------------------------------------------//
+
+//Settings of the "default" Keyspace - Every Read is Repaired and Missed Writes are Hinted (When the Mode Allows)
+func DefaultKeyspaceSettings() keyspaceSettings {
+
+	settings := keyspaceSettings{}
+	settings.ReplicationFactor = defaultReplicationFactor
+	settings.ReadRepairChance = defaultReadRepairChance
+	settings.HintedHandOff = true
+
+	return settings
+}
+
+//---------------------------------------------------------------------------//
+
+func (ks keyspaceSettings) Strategy() Ring.Strategy {
+	return Ring.Strategy{ReplicationFactor: ks.ReplicationFactor, Datacenters: ks.Datacenters}
+}
+
+//---------------------------------------------------------------------------//
+
+func ValidKeyspaceName(keyspace string) bool {
+
+	if keyspace == "" || len(keyspace) > maxKeyspaceNameLength {
+		return false
+	}
+
+	for _, char := range keyspace {
+		if !(char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' || char >= '0' && char <= '9' || char == '_') {
+			return false
+		}
+	}
+
+	return true
+}
+
+//---------------------------------------------------------------------------//
+
+func InitializeReplica(replicaInitMsg *cassandra.InitReplicaCluster) bool {
+
+	//One Initialization Only; Requests See the Replica Initialized Once the Ring is Built
+	if replicaInitialized.Load() || !replicaInitializing.CompareAndSwap(false, true) {
+		fmt.Println("Replica already Initialized.")
+		return false
+	}
+
+	//Initialize Replica
+	InitializeCluster(*replicaInitMsg)
+
+	//Place the Replicas on the Token Ring
+	BuildTokenRing()
+
+	replicaInitialized.Store(true)
+
+	return true
+}
+
+//---------------------------------------------------------------------------//
+
+func InitializeCluster(replicaInitMsg cassandra.InitReplicaCluster) {
+
+	allReplicas := replicaInitMsg.GetAllReplica()
+
+	for _, thisReplica := range allReplicas {
+
+		//If details of Same Replica
+		if thisReplica.Name == myConfig.Name {
+
+			myConfig.IP = thisReplica.Ip
+			myConfig.Port = thisReplica.Port
+			if thisReplica.GetDatacenter() != "" {
+				myConfig.Datacenter = thisReplica.GetDatacenter()
+				myConfig.Rack = thisReplica.GetRack()
+			}
+
+		} else {
+
+			newReplica := new(replica)
+			newReplica.Name = thisReplica.Name
+			newReplica.IP = thisReplica.Ip
+			newReplica.Port = thisReplica.Port
+			newReplica.GrpcPort = thisReplica.GrpcPort
+			if newReplica.GrpcPort == "" {
+				newReplica.GrpcPort = DefaultGrpcPort(newReplica.Port)
+			}
+			newReplica.TCPAddress, _ = net.ResolveTCPAddr("tcp", newReplica.IP+":"+newReplica.Port)
+
+			//Add it to the Cluster Configuration
+			myReplicaCluster.Add(*newReplica)
+
+			tokenRing.SetLocation(thisReplica.Name, thisReplica.GetDatacenter(), thisReplica.GetRack())
+
+		}
+
+		replicaNames = append(replicaNames, thisReplica.Name)
+
+	}
+
+	//Gossip Advertises the Address and Location the Client Gave this Replica
+	tokenRing.SetLocation(myConfig.Name, myConfig.Datacenter, myConfig.Rack)
+	gossipTable.SetAddress(myConfig.IP, myConfig.Port, myConfig.GrpcPort)
+	gossipTable.SetLocation(myConfig.Datacenter, myConfig.Rack)
+
+	fmt.Println(myConfig.Name, "Initialized From Client Request")
+
+}
+
+//---------------------------------------------------------------------------//
+
+func ClusterSetup(replicaConfigFile string) {
+
+	file, err := os.Open("../Client/" + replicaConfigFile)
+	if err != nil {
+		fmt.Printf("error opening file: %v\n", err)
+		os.Exit(1)
+	}
+
+	//Read Config File Content
+	fileContent := bufio.NewReader(file)
+	fileBuff, _, err := fileContent.ReadLine()
+
+	for err == nil {
+
+		replicaLine := string(fileBuff)
+		replicaDtl := strings.Split(replicaLine, " ")
+
+		grpcPort, datacenter, rack := ConfigColumns(replicaDtl[3:])
+
+		//If details of Same Replica
+		if replicaDtl[0] == myConfig.Name {
+
+			myConfig.IP = replicaDtl[1]
+			myConfig.Port = replicaDtl[2]
+			if grpcPort != "" {
+				myConfig.GrpcPort = grpcPort
+			}
+			if datacenter != "" {
+				myConfig.Datacenter = datacenter
+				myConfig.Rack = rack
+			}
+
+		} else {
+
+			newReplica := new(replica)
+			newReplica.Name = replicaDtl[0]
+			newReplica.IP = replicaDtl[1]
+			newReplica.Port = replicaDtl[2]
+			newReplica.GrpcPort = DefaultGrpcPort(newReplica.Port)
+			if grpcPort != "" {
+				newReplica.GrpcPort = grpcPort
+			}
+			newReplica.TCPAddress, _ = net.ResolveTCPAddr("tcp", newReplica.IP+":"+newReplica.Port)
+
+			//Add it to the Cluster Configuration
+			myReplicaCluster.Add(*newReplica)
+
+			tokenRing.SetLocation(replicaDtl[0], datacenter, rack)
+
+		}
+
+		replicaNames = append(replicaNames, replicaDtl[0])
+
+		fileBuff, _, err = fileContent.ReadLine()
+
+	}
+
+	fmt.Println(myConfig.Name, "Initialized Using Configure File")
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Optional Config File Columns After the Port, in Any Order: the gRPC Port and the Snitch's <Datacenter>:<Rack>
+func ConfigColumns(columns []string) (string, string, string) {
+
+	grpcPort, datacenter, rack := "", "", ""
+
+	for _, column := range columns {
+
+		if column == "" {
+			continue
+		}
+
+		if columnDC, columnRack, isLocation := strings.Cut(column, ":"); isLocation {
+			datacenter, rack = columnDC, columnRack
+		} else {
+			grpcPort = column
+		}
+
+	}
+
+	return grpcPort, datacenter, rack
+}
+
+//---------------------------------------------------------------------------//
+
+//Start Gossiping: This Replica's Own Record, the Seed Connections, and the Gossip Rounds
+func StartGossip(seeds []string) {
+
+	self := new(cassandra.EndpointState)
+	self.Name = myConfig.Name
+	self.Ip = myConfig.IP
+	self.Port = myConfig.Port
+	self.GrpcPort = myConfig.GrpcPort
+	self.Datacenter = myConfig.Datacenter
+	self.Rack = myConfig.Rack
+	self.Generation = time.Now().Unix()
+	self.Status = cassandra.EndpointState_NORMAL
+	self.Tokens = Ring.ReplicaTokens(myConfig.Name, tokenRing.Vnodes)
+
+	//A Bootstrapping Replica Announces its Tokens but Does Not Own them Yet; a Replacement
+	//Announces the Dead Replica Instead, as it Only Learns the Tokens Through Gossip
+	if bootstrapping.Load() {
+		self.Status = cassandra.EndpointState_JOINING
+	}
+	if replaceReplica != "" {
+		self.Tokens = nil
+		self.Replacing = replaceReplica
+	}
+
+	gossipTable = Gossip.New(self)
+
+	for _, seed := range seeds {
+
+		seedAddress, err := net.ResolveTCPAddr("tcp", strings.TrimSpace(seed))
+		if err != nil {
+			fmt.Println("Gossip: Invalid Seed", seed, err)
+			continue
+		}
+
+		//A Replica is Not its Own Seed
+		if strconv.Itoa(seedAddress.Port) == myConfig.Port && (seedAddress.IP.IsLoopback() || seedAddress.IP.String() == myConfig.IP) {
+			continue
+		}
+
+		gossipSeeds = append(gossipSeeds, Transport.NewPool(seedAddress, constOne))
+
+	}
+
+	if bootstrapping.Load() && len(gossipSeeds) == 0 {
+		log.Fatal("Bootstrap Needs a Seed Other than this Replica")
+	}
+
+	//Joining Through Seeds: Own Tokens Go on the Ring Now, the Rest as Gossip Brings them
+	if len(seeds) > 0 && !bootstrapping.Load() {
+
+		tokenRing.SetTokens(myConfig.Name, self.Tokens)
+
+		//The Only Seed Starts the Cluster
+		if len(gossipSeeds) == 0 {
+			replicaInitialized.Store(true)
+			fmt.Println(myConfig.Name, "Initialized as the First Seed")
+		}
+
+	}
+
+	go GossipLoop()
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Every Round: Advance the Heartbeat, Swap Views with One Random Peer (and a Seed if the
+//Peer was Not One), then Ask the Failure Detector Which Replicas Went Down or Came Back
+func GossipLoop() {
+
+	for {
+
+		time.Sleep(gossipInterval)
+
+		//Without Seeds, Gossip Starts Once the Client (or Config File) Names the Peers
+		if !replicaInitialized.Load() && len(gossipSeeds) == 0 {
+			continue
+		}
+
+		gossipTable.Beat()
+
+		peerWasSeed := false
+		if peers := myReplicaCluster.All(); len(peers) > 0 {
+
+			peer := peers[rand.Intn(len(peers))]
+			if err := GossipWith(peer.Pool); err == nil {
+				peerWasSeed = IsSeed(peer.TCPAddress)
+			}
+
+		}
+
+		if !peerWasSeed && len(gossipSeeds) > 0 {
+
+			if err := GossipWith(gossipSeeds[rand.Intn(len(gossipSeeds))]); err == nil && !bootstrapping.Load() && replicaInitialized.CompareAndSwap(false, true) {
+				fmt.Println(myConfig.Name, "Initialized Through Gossip -", myReplicaCluster.Count(), "Other Replicas Known")
+			}
+
+		}
+
+		wentDown, cameUp := failureDetector.Check()
+		for _, replicaName := range wentDown {
+			fmt.Println("Failure Detector:", replicaName, "is DOWN")
+		}
+
+		//A Replica Back UP Gets its Hints Right Away
+		for _, replicaName := range cameUp {
+			fmt.Println("Failure Detector:", replicaName, "is UP")
+			go DeliverHints(replicaName)
+		}
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//One Push-Pull Exchange: Send Our View, Merge the Answer
+func GossipWith(pool *Transport.Pool) error {
+
+	gossipMessage := new(cassandra.InputRequest_Gossip)
+	gossipMessage.Gossip = new(cassandra.Gossip)
+	gossipMessage.Gossip.States = gossipTable.Snapshot()
+
+	//Input Request Message
+	replicaMsg := new(cassandra.InputRequest)
+	replicaMsg.InputRequest = gossipMessage
+
+	respMsg, err := pool.Call(replicaMsg, gossipRequestTimeout)
+	if err != nil {
+		return err
+	}
+
+	if respMsg.GetGossip() == nil {
+		return errors.New(respMsg.GetResponse().GetRespMessage())
+	}
+
+	ApplyEndpointStates(gossipTable.Merge(respMsg.GetGossip().GetStates()))
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+func IsSeed(address *net.TCPAddr) bool {
+
+	for _, seed := range gossipSeeds {
+		if address != nil && seed.Address.String() == address.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+//---------------------------------------------------------------------------//
+
+//Replica: Merge the Sender's View and Answer with Ours
+func ProcessGossip(gossipMsg *cassandra.Gossip, replicaSocket responder) {
+
+	//Without Seeds, an Uninitialized Replica Waits for the Client; it Only Answers
+	if replicaInitialized.Load() || len(gossipSeeds) > 0 {
+		ApplyEndpointStates(gossipTable.Merge(gossipMsg.GetStates()))
+	}
+
+	gossipMessage := new(cassandra.InputRequest_Gossip)
+	gossipMessage.Gossip = new(cassandra.Gossip)
+	gossipMessage.Gossip.States = gossipTable.Snapshot()
+
+	sendResponse := new(cassandra.InputRequest)
+	sendResponse.InputRequest = gossipMessage
+
+	if err := replicaSocket.Send(sendResponse); err != nil {
+		fmt.Println("Gossip: Error while sending response.!", err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Bring the Cluster and the Token Ring in Line with Newer Endpoint States: a New Replica is
+//Connected To, a JOINING One Takes No Tokens Yet, a Replica that LEFT is Dropped
+func ApplyEndpointStates(states []*cassandra.EndpointState) {
+
+	for _, state := range states {
+
+		replicaName := state.GetName()
+
+		//A Newer Version is a Heartbeat
+		failureDetector.Report(replicaName)
+
+		if state.GetStatus() == cassandra.EndpointState_LEFT {
+
+			pendingReplicas.Remove(replicaName)
+			failureDetector.Remove(replicaName)
+
+			if _, isPeer := myReplicaCluster.Get(replicaName); isPeer {
+				tokenRing.RemoveReplica(replicaName)
+				myReplicaCluster.Remove(replicaName)
+				fmt.Println("Gossip:", replicaName, "Left the Cluster")
+			}
+
+			continue
+		}
+
+		newReplica := new(replica)
+		newReplica.Name = replicaName
+		newReplica.IP = state.GetIp()
+		newReplica.Port = state.GetPort()
+		newReplica.GrpcPort = state.GetGrpcPort()
+		if newReplica.GrpcPort == "" {
+			newReplica.GrpcPort = DefaultGrpcPort(newReplica.Port)
+		}
+
+		var err error
+		newReplica.TCPAddress, err = net.ResolveTCPAddr("tcp", newReplica.IP+":"+newReplica.Port)
+		if err != nil {
+			fmt.Println("Gossip: Invalid Address for", replicaName, err)
+			continue
+		}
+
+		if myReplicaCluster.Add(*newReplica) {
+			fmt.Println("Gossip: Discovered", replicaName, newReplica.IP+":"+newReplica.Port, state.GetStatus(),
+				state.GetDatacenter()+":"+state.GetRack())
+		}
+
+		//Snitch: Place the Replica Where it Says it Runs
+		location := Ring.Location{Datacenter: state.GetDatacenter(), Rack: state.GetRack()}
+		if location.Datacenter != "" && tokenRing.Location(replicaName) != location {
+			tokenRing.SetLocation(replicaName, location.Datacenter, location.Rack)
+		}
+
+		tokens := state.GetTokens()
+		if len(tokens) == 0 && state.GetReplacing() != "" {
+			tokens = tokenRing.Tokens(state.GetReplacing())
+		}
+		if len(tokens) == 0 {
+			tokens = Ring.ReplicaTokens(replicaName, tokenRing.Vnodes)
+		}
+
+		//A JOINING Replica's Tokens are Pending - it Gets the Writes for them, Not the Reads
+		if state.GetStatus() == cassandra.EndpointState_JOINING {
+			if len(tokenRing.Tokens(replicaName)) > 0 {
+				tokenRing.RemoveReplica(replicaName)
+			}
+			pendingReplicas.Join(replicaName, tokens, state.GetReplacing())
+			continue
+		}
+
+		//A LEAVING Replica Keeps Serving its Ranges While it Streams them to their Next Owners
+		if state.GetStatus() == cassandra.EndpointState_LEAVING {
+			pendingReplicas.Leave(replicaName)
+		} else {
+			pendingReplicas.Remove(replicaName)
+		}
+
+		//Only Rebuild the Ring When the Replica's Tokens Change
+		if !slices.Equal(tokenRing.Tokens(replicaName), tokens) {
+			tokenRing.SetTokens(replicaName, tokens)
+			fmt.Println("Gossip:", replicaName, state.GetStatus(), "-", len(tokens), "Tokens on the Ring")
+		}
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Join a Running Cluster: Learn the Keyspaces and the Ring, Wait for Every Coordinator to See this Replica
+//JOINING (so New Writes Reach it), Stream the Ranges it Will Own from their Current Owners, then Take the Tokens
+func Bootstrap(storageWriter *Storage.CommitLog) {
+
+	myTokens := Ring.ReplicaTokens(myConfig.Name, tokenRing.Vnodes)
+
+	//The First Gossip Exchange with a Seed Brings the Cluster
+	for myReplicaCluster.Count() == 0 {
+		time.Sleep(gossipInterval)
+	}
+
+	if err := FetchKeyspaces(); err != nil {
+		log.Fatal("Bootstrap: ", err)
+	}
+
+	fmt.Println("Bootstrap: JOINING -", myReplicaCluster.Count(), "Other Replicas Known. Waiting", bootstrapRingDelay*gossipInterval, "for the Ring to Settle")
+	time.Sleep(bootstrapRingDelay * gossipInterval)
+
+	//A Replacement Takes the Dead Replica's Tokens - Once Sure it is Dead
+	if replaceReplica != "" {
+		myTokens = ReplacedTokens(replaceReplica)
+	}
+
+	//The Ring as it Will Be Once this Replica Takes its Tokens
+	futureRing := tokenRing.Clone()
+	if replaceReplica != "" {
+		futureRing.RemoveReplica(replaceReplica)
+	}
+	futureRing.SetTokens(myConfig.Name, myTokens)
+
+	rangesStreamed := 0
+	rowsStreamed := 0
+	for _, keyspace := range KeyspaceConfig.Names() {
+
+		strategy := KeyspaceConfig.Strategy(keyspace)
+
+		for _, tokenRange := range futureRing.Ranges(strategy) {
+
+			if !slices.Contains(tokenRange.Replicas, myConfig.Name) {
+				continue
+			}
+
+			//No Current Token Lies Inside the Range, so the Owners of its End Own All of it
+			owners := tokenRing.TokenPreferenceList(tokenRange.End, strategy)
+			owners = slices.DeleteFunc(owners, func(replicaName string) bool { return replicaName == replaceReplica })
+
+			rows, err := StreamRange(keyspace, tokenRange, owners, storageWriter)
+			if err != nil {
+				log.Fatal("Bootstrap: ", err)
+			}
+
+			rangesStreamed++
+			rowsStreamed += rows
+
+		}
+
+	}
+
+	//The Dead Replica is Gone for Good - Gossip Tells the Others on its Behalf
+	if replaceReplica != "" {
+		gossipTable.MarkLeft(replaceReplica)
+		tokenRing.RemoveReplica(replaceReplica)
+		myReplicaCluster.Remove(replaceReplica)
+		fmt.Println("Bootstrap: Replaced", replaceReplica)
+	}
+
+	//Take the Tokens - Other Replicas Move the Ranges Here as Gossip Reaches them
+	tokenRing.SetTokens(myConfig.Name, myTokens)
+	gossipTable.SetStatus(cassandra.EndpointState_NORMAL, myTokens)
+	replicaInitialized.Store(true)
+	bootstrapping.Store(false)
+
+	fmt.Println("Bootstrap: NORMAL -", rangesStreamed, "Ranges,", rowsStreamed, "Rows Streamed.", myConfig.Name, "Initialized")
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Tokens of the Replica Being Replaced. It Must be Dead: its Heartbeat Must Not Advance for Another
+//Ring Delay - Long Enough for Any Heartbeat Still Spreading to Arrive
+func ReplacedTokens(deadReplica string) []int64 {
+
+	deadState, found := gossipTable.Get(deadReplica)
+	if !found || deadState.GetStatus() == cassandra.EndpointState_LEFT {
+		log.Fatal("Replace: ", deadReplica, " is Not a Member of the Cluster")
+	}
+
+	tokens := tokenRing.Tokens(deadReplica)
+	if len(tokens) == 0 {
+		log.Fatal("Replace: ", deadReplica, " Owns No Tokens")
+	}
+
+	fmt.Println("Replace: Checking", deadReplica, "is Dead -", bootstrapRingDelay*gossipInterval)
+	time.Sleep(bootstrapRingDelay * gossipInterval)
+
+	if latestState, _ := gossipTable.Get(deadReplica); Gossip.Newer(latestState, deadState) {
+		log.Fatal("Replace: ", deadReplica, " is Still Alive - its Heartbeat is Advancing")
+	}
+
+	return tokens
+}
+
+//---------------------------------------------------------------------------//
+
+//Ask the Known Replicas, One at a Time, for Every Keyspace Definition
+func FetchKeyspaces() error {
+
+	schemaMessage := new(cassandra.InputRequest_SchemaRequest)
+	schemaMessage.SchemaRequest = new(cassandra.SchemaRequest)
+
+	replicaMsg := new(cassandra.InputRequest)
+	replicaMsg.InputRequest = schemaMessage
+
+	for _, eachReplica := range myReplicaCluster.All() {
+
+		respMsg, err := eachReplica.Pool.Call(replicaMsg, replicaRequestTimeout)
+		if err != nil || respMsg.GetSchema() == nil {
+			continue
+		}
+
+		for _, keyspaceMsg := range respMsg.GetSchema().GetKeyspaces() {
+			if err := KeyspaceConfig.Define(keyspaceMsg.GetName(), KeyspaceSettings(keyspaceMsg)); err != nil {
+				fmt.Println("Bootstrap: Keyspace", keyspaceMsg.GetName(), err)
+			}
+		}
+
+		fmt.Println("Bootstrap:", len(respMsg.GetSchema().GetKeyspaces()), "Keyspaces from", eachReplica.Name)
+
+		return nil
+	}
+
+	return errors.New("No Replica Sent the Keyspace Definitions")
+}
+
+//---------------------------------------------------------------------------//
+
+//Copy Every Row of the Range from the First Live Owner that Can Send it; Returns the Rows Written
+func StreamRange(keyspace string, tokenRange Ring.Range, owners []string, storageWriter *Storage.CommitLog) (int, error) {
+
+	for _, replicaName := range owners {
+
+		eachReplica, isPeer := myReplicaCluster.Get(replicaName)
+		if !isPeer || !failureDetector.Alive(replicaName) {
+			continue
+		}
+
+		rows, err := StreamRangeFrom(eachReplica, keyspace, tokenRange, storageWriter)
+		if err == nil {
+			return rows, nil
+		}
+
+		fmt.Println("Bootstrap: Streaming from", replicaName, "Failed.", err)
+
+	}
+
+	return 0, fmt.Errorf("No Owner Could Stream Keyspace %s Range (%d, %d]", keyspace, tokenRange.Start, tokenRange.End)
+}
+
+//---------------------------------------------------------------------------//
+
+//Fetch the Range One Leaf at a Time, so No Single Message Holds the Whole Range
+func StreamRangeFrom(eachReplica replica, keyspace string, tokenRange Ring.Range, storageWriter *Storage.CommitLog) (int, error) {
+
+	rows := 0
+	for leaf := 0; leaf < 1<<streamDepth; leaf++ {
+
+		rowsMessage := new(cassandra.InputRequest_RepairRowsRequest)
+		rowsMessage.RepairRowsRequest = new(cassandra.RepairRowsRequest)
+		rowsMessage.RepairRowsRequest.Keyspace = keyspace
+		rowsMessage.RepairRowsRequest.Range = &cassandra.TokenRange{Start: tokenRange.Start, End: tokenRange.End}
+		rowsMessage.RepairRowsRequest.Depth = streamDepth
+		rowsMessage.RepairRowsRequest.Leaves = []uint32{uint32(leaf)}
+
+		replicaMsg := new(cassandra.InputRequest)
+		replicaMsg.InputRequest = rowsMessage
+
+		respMsg, err := eachReplica.Pool.Call(replicaMsg, replicaRequestTimeout)
+		if err != nil {
+			return rows, err
+		}
+
+		rowsMsg := respMsg.GetRepairRows()
+		if rowsMsg == nil {
+			return rows, errors.New(respMsg.GetResponse().GetRespMessage())
+		}
+
+		//Writes Already Sent Here While JOINING May be Newer than the Streamed Rows
+		for _, row := range rowsMsg.GetRows() {
 
-			fmt.Println("Hinted-HandOff: Replica:", hint.ReplicaName, "Key:",hint.Key, "Value",
-				hint.Value, "Time:", hint.Arrived)
+			keyValues, err := KeyValueConfig.ReadValue(keyspace, row.GetKey())
+			if err != nil {
+				return rows, err
+			}
+			if keyValues.Arrived > row.GetTimeInSeconds() {
+				continue
+			}
+
+			row.Keyspace = keyspace
+			if err := WriteToStorage(row, storageWriter); err != nil {
+				return rows, err
+			}
+			rows++
 
 		}
 
 	}
 
+	if rows > 0 {
+		fmt.Println("Bootstrap: Streamed", rows, "Rows from", eachReplica.Name, "Keyspace:", keyspace)
+	}
+
+	return rows, nil
 }
 
 //---------------------------------------------------------------------------//
 
-func ReadRepair(finalValOfThisKey latestVal, readRepairLog map[string]latestVal) {
+//A JOINING Replica Will Take the Tokens - and Replace a Dead Replica, if Named
+func (pt *pendingTable) Join(replicaName string, tokens []int64, replaces string) {
 
-	for _, eachReplicaVal := range readRepairLog {
+	pt.mtx.Lock()
+	defer pt.mtx.Unlock()
 
-		if finalValOfThisKey.Arrived > eachReplicaVal.Arrived {
+	pt.joining[replicaName] = append([]int64{}, tokens...)
+	if replaces != "" {
+		pt.replacing[replicaName] = replaces
+	}
 
-			//Stale Value Can be From Coordinator
-			if eachReplicaVal.Replica == myConfig.Name {
+}
 
-				KeyValueConfig.UpdateValue(finalValOfThisKey.Key, finalValOfThisKey.Value, finalValOfThisKey.Arrived)
+//---------------------------------------------------------------------------//
 
-			} else {
+func (pt *pendingTable) Leave(replicaName string) {
 
-				//Stale Value from Other Replicas
+	pt.mtx.Lock()
+	defer pt.mtx.Unlock()
 
-				replicaPutMessage := new(cassandra.InputRequest_ReplicaPut)
-				replicaPutMessage.ReplicaPut = new(cassandra.ReplicaPut)
-				replicaPutMessage.ReplicaPut.Input = new(cassandra.RequestParameter)
+	pt.leaving[replicaName] = true
 
-				replicaPutMessage.ReplicaPut.Input.Key = finalValOfThisKey.Key
-				replicaPutMessage.ReplicaPut.Input.Value = finalValOfThisKey.Value
-				replicaPutMessage.ReplicaPut.Input.OriginReplica = finalValOfThisKey.Replica
-				replicaPutMessage.ReplicaPut.Input.TimeInSeconds = finalValOfThisKey.Arrived
+}
 
-				//Input Request Message
-				replicaMsg := new(cassandra.InputRequest)
-				replicaMsg.InputRequest = replicaPutMessage
+//---------------------------------------------------------------------------//
 
-				//Proto-buf Message
-				protoReplicaPutMsg, _ := proto.Marshal(replicaMsg)
+func (pt *pendingTable) Remove(replicaName string) {
 
-				//Send ReplicaPut Message
-				connection, _ := net.DialTCP("tcp", nil, myReplicaCluster[eachReplicaVal.Replica].TCPAddress)
-				connection.Write(protoReplicaPutMsg)
+	pt.mtx.Lock()
+	defer pt.mtx.Unlock()
 
-				fmt.Println("Read Repair:", "Replica:", eachReplicaVal.Replica, "Key:", finalValOfThisKey.Key,
-				"Value:", finalValOfThisKey.Value, "Time:", finalValOfThisKey.Arrived)
-			}
+	delete(pt.joining, replicaName)
+	delete(pt.replacing, replicaName)
+	delete(pt.leaving, replicaName)
 
+}
+
+//---------------------------------------------------------------------------//
+
+//Replicas that Will Own the Key Once Every Ring Change Under Way Completes, but Do Not Own it Now
+func (pt *pendingTable) Replicas(keyspace string, key []byte) []string {
+
+	pt.mtx.Lock()
+	defer pt.mtx.Unlock()
+
+	if len(pt.joining) == 0 && len(pt.leaving) == 0 {
+		return nil
+	}
+
+	futureRing := tokenRing.Clone()
+	for replicaName := range pt.leaving {
+		futureRing.RemoveReplica(replicaName)
+	}
+	for replicaName, tokens := range pt.joining {
+		if deadReplica, replaces := pt.replacing[replicaName]; replaces {
+			futureRing.RemoveReplica(deadReplica)
 		}
+		futureRing.SetTokens(replicaName, tokens)
+	}
 
+	owners := KeyReplicas(keyspace, key)
+
+	pending := []string{}
+	for _, replicaName := range futureRing.PreferenceList(key, KeyspaceConfig.Strategy(keyspace)) {
+		if !slices.Contains(owners, replicaName) {
+			pending = append(pending, replicaName)
+		}
 	}
 
+	return pending
 }
 
 //---------------------------------------------------------------------------//
 
-func SendResponseToClient(key uint32, replicaSocket *net.TCPConn) {
-
-	replicaResponse := new(cassandra.InputRequest_Response)
-	replicaResponse.Response = new(cassandra.Response)
-	replicaResponse.Response.Key = key
-	replicaResponse.Response.OriginReplica = myConfig.Name
-	replicaResponse.Response.Status = true
-	replicaResponse.Response.RespMessage = "Key-Value Pair is Successfully Stored..!"
-
-	sendResponse := new(cassandra.InputRequest)
-	sendResponse.InputRequest = replicaResponse
+func (ct *clusterTable) Get(replicaName string) (replica, bool) {
 
-	protoRespMsg, _ := proto.Marshal(sendResponse)
-	replicaSocket.Write(protoRespMsg)
+	ct.mtx.RLock()
+	defer ct.mtx.RUnlock()
 
-	fmt.Println("Client PUT:", "Key:", key, "Value:", KeyValueConfig.KeyValues[key].MyValue, "Time:", KeyValueConfig.KeyValues[key].Arrived)
+	eachReplica, found := ct.replicas[replicaName]
 
+	return eachReplica, found
 }
 
 //---------------------------------------------------------------------------//
 
-func MakeHints(replicaName string, clientPutMsg *cassandra.ClientPut) {
+//Every Other Replica, by Name
+func (ct *clusterTable) All() []replica {
 
-	// Make Hints for Hinted-HandsOff
-	hintCount++
-	newHint := new(hints)
-	newHint.ReplicaName = replicaName
-	newHint.Key = clientPutMsg.Input.GetKey()
-	newHint.Value = clientPutMsg.Input.GetValue()
-	newHint.Arrived = clientPutMsg.Input.GetTimeInSeconds()
+	ct.mtx.RLock()
+	defer ct.mtx.RUnlock()
+
+	replicas := make([]replica, 0, len(ct.replicas))
+	for _, eachReplica := range ct.replicas {
+		replicas = append(replicas, eachReplica)
+	}
+	sort.Slice(replicas, func(i, j int) bool { return replicas[i].Name < replicas[j].Name })
+
+	return replicas
+}
+
+//---------------------------------------------------------------------------//
 
-	hintedHandOff[hintCount] = *newHint
+func (ct *clusterTable) Count() int {
 
-	fmt.Println("Hint Logged:", "Key:", hintedHandOff[hintCount].Key, "Value:", "Time:", hintedHandOff[hintCount].Value, hintedHandOff[hintCount].Arrived)
+	ct.mtx.RLock()
+	defer ct.mtx.RUnlock()
 
+	return len(ct.replicas)
 }
 
 //---------------------------------------------------------------------------//
 
-func CheckReplicaStatus(key uint32, consistency string) bool {
+//Add (or Re-Address) a Replica and Open its Connection Pool; False if Nothing Changed
+func (ct *clusterTable) Add(newReplica replica) bool {
 
-	replicaAlive := 0
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
 
-	//Am I the coordinator.?
-	if KeyBelongsToMe(key) {
-		replicaAlive++
+	if current, found := ct.replicas[newReplica.Name]; found {
 
-		//Consistency = ONE
-		if consistency == consistencyOne {
-			return true
+		if current.TCPAddress.String() == newReplica.TCPAddress.String() && current.GrpcPort == newReplica.GrpcPort {
+			return false
 		}
 
+		current.Pool.Close()
 	}
 
-	//Check Other Replicas
-	for _, eachReplica := range myReplicaCluster {
+	newReplica.Pool = Transport.NewPool(newReplica.TCPAddress, connectionsPerReplica)
+	ct.replicas[newReplica.Name] = newReplica
 
-		if eachReplica.Name == KeyValueConfig.KeyValues[key].ReplicaAssigned1 ||
-			eachReplica.Name == KeyValueConfig.KeyValues[key].ReplicaAssigned2 ||
-			eachReplica.Name == KeyValueConfig.KeyValues[key].ReplicaAssigned3 {
+	//A New Replica Starts Out UP; Phi Convicts it if No Heartbeat Follows
+	failureDetector.Report(newReplica.Name)
 
-			//Send Replica Test message
-			connection, err := net.DialTCP("tcp", nil, eachReplica.TCPAddress)
+	return true
+}
 
-			//Replica UP and Running.....
-			if err == nil {
-				replicaAlive++
-				connection.Write([]byte("Testing Connection..!!!!"))
-			}
+//---------------------------------------------------------------------------//
 
-			//For Consistency ONE Minimum 1 Replicas must be UP
-			if consistency == consistencyOne && replicaAlive >= constOne {
-				return true
-			}
+func (ct *clusterTable) Remove(replicaName string) {
 
-			//For Consistency QUORUM Minimum 2 Replicas must be UP
-			if consistency == consistencyQuorum && replicaAlive > constOne {
-				return true
-			}
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
 
-		}
+	if current, found := ct.replicas[replicaName]; found {
+		current.Pool.Close()
+		delete(ct.replicas, replicaName)
 	}
 
-	//Not Enough Replicas are UP
-	return false
+	failureDetector.Remove(replicaName)
 
 }
 
 //---------------------------------------------------------------------------//
 
-func KeyBelongsToMe(key uint32) bool {
+func BuildTokenRing() {
 
-	if KeyValueConfig.KeyValues[key].ReplicaAssigned1 == myConfig.Name ||
-		KeyValueConfig.KeyValues[key].ReplicaAssigned2 == myConfig.Name ||
-		KeyValueConfig.KeyValues[key].ReplicaAssigned3 == myConfig.Name {
-		return true
+	for _, replicaName := range replicaNames {
+		tokenRing.AddReplica(replicaName)
 	}
 
-	return false
+	fmt.Println(myConfig.Name, "Token Ring:", len(replicaNames), "Replicas,", tokenRing.Vnodes, "Tokens Each")
+
 }
 
 //---------------------------------------------------------------------------//
 
-func NotEnoughReplicaMsg(key uint32, replicaSocket *net.TCPConn) {
-
-	//Send Response to Client
-	replicaResponse := new(cassandra.InputRequest_Response)
-	replicaResponse.Response = new(cassandra.Response)
-	replicaResponse.Response.Key = key
-	replicaResponse.Response.OriginReplica = myConfig.Name
-	replicaResponse.Response.Status = false
-	replicaResponse.Response.RespMessage = "Cannot Process This Request. Not Enough Replicas are UP for this request.!"
+func WriteToStorage(putMsg *cassandra.RequestParameter, storageWriter *Storage.CommitLog) error {
 
-	sendResponse := new(cassandra.InputRequest)
-	sendResponse.InputRequest = replicaResponse
+	keyspace := KeyspaceName(putMsg.GetKeyspace())
 
-	protoRespMsg, _ := proto.Marshal(sendResponse)
-	replicaSocket.Write(protoRespMsg)
+	//Then to the Storage Engine
+	update := func() error {
+		return KeyValueConfig.UpdateValue(keyspace, putMsg.GetKey(), putMsg.GetValue(), putMsg.GetTimeInSeconds())
+	}
 
-	fmt.Println("Replica Exception: Not Enough Replicas are UP...!!! ")
+	//Logged First - Once the Sync Mode Counts it Durable, the Write Survives a Crash. Without a
+	//Storage Log, the Engine Logs the Write Itself
+	if storageWriter != nil {
+		row := Storage.Row{Value: putMsg.GetValue(), Timestamp: putMsg.GetTimeInSeconds()}
+		return storageWriter.Append([]byte(StorageKey(keyspace, putMsg.GetKey())), row, update)
+	}
 
+	return update()
 }
 
 //---------------------------------------------------------------------------//
 
-func (cs *criticalSection) UpdateValue(keyVal uint32, value string, timeValLatest int64) {
+//Recover the Storage Log - a Record Torn by a Crash Mid-Write, and Anything After it, is Cut Off so
+//New Writes Follow the Last Whole One. When Rebooting, Every Row is Loaded Back into the Engine
+func ReloadValue(fileName string, reload bool) {
 
-	cs.mtx.Lock()
-	defer cs.mtx.Unlock()
+	replayed, truncated, err := Storage.RecoverCommitLog(fileName, func(storageKey string, row Storage.Row) error {
 
-	currentKeyVal := KeyValueConfig.KeyValues[keyVal]
+		if !reload {
+			return nil
+		}
+
+		//Load the Latest Value - the Storage Engine Keeps the Newer of Two Versions
+		if err := KeyValueConfig.Engine.Put([]byte(storageKey), row); err != nil {
+			return err
+		}
 
-	//If the Value Receive with higher timestamp, then update the value, timestamp
-	if timeValLatest > currentKeyVal.Arrived {
+		keyspace, key, _ := strings.Cut(storageKey, "\x00")
+		fmt.Println(keyspace, key, row.Value, row.Timestamp)
 
-		//Update
-		currentKeyVal.MyValue = value
-		currentKeyVal.Arrived = timeValLatest
-		KeyValueConfig.KeyValues[keyVal] = currentKeyVal
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
 
+	if reload {
+		fmt.Println("Storage Log:", fileName, replayed, "Writes Replayed")
+	}
+	if truncated > 0 {
+		fmt.Println("Storage Log:", fileName, "Torn Tail of", truncated, "Bytes Dropped")
 	}
 
 }
 
 //---------------------------------------------------------------------------//
 
-func (cs *criticalSection) ReadValue(keyVal uint32) keyConfig {
+//Move a Text Storage File (key@#value@#time@#keyspace Lines) Left by an Older Version into the Storage
+//Log, then Rename it Out of the Way. Malformed Lines are Reported and Skipped
+func ImportStorageText(fileName string, storageWriter *Storage.CommitLog, reload bool) {
+
+	fileId, err := os.Open(fileName)
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	imported, skipped := 0, 0
+	fileBuf := bufio.NewScanner(fileId)
+	fileBuf.Buffer(nil, int(Transport.MaxMessageSize))
+
+	for lineNumber := 1; fileBuf.Scan(); lineNumber++ {
+
+		data := strings.Split(fileBuf.Text(), separator)
+		if len(data) < 3 || len(data) > 4 {
+			fmt.Println("Storage File:", fileName, "Line", lineNumber, "Skipped - Expected 3 or 4 Fields, Found", len(data))
+			skipped++
+			continue
+		}
+
+		key, err := hex.DecodeString(data[0])
+		if err != nil {
+			fmt.Println("Storage File:", fileName, "Line", lineNumber, "Skipped - Bad Key:", err)
+			skipped++
+			continue
+		}
+		timeVal, err := strconv.ParseInt(data[2], 10, 64)
+		if err != nil {
+			fmt.Println("Storage File:", fileName, "Line", lineNumber, "Skipped - Bad Time:", err)
+			skipped++
+			continue
+		}
+
+		//Lines Written Before Keyspaces Belong to the Default Keyspace
+		keyspace := defaultKeyspace
+		if len(data) > 3 {
+			keyspace = data[3]
+		}
+
+		var update func() error
+		if reload {
+			update = func() error { return KeyValueConfig.UpdateValue(keyspace, key, data[1], timeVal) }
+		}
+
+		row := Storage.Row{Value: data[1], Timestamp: timeVal}
+		if err := storageWriter.Append([]byte(StorageKey(keyspace, key)), row, update); err != nil {
+			log.Fatal(err)
+		}
+		imported++
+
+	}
+	fileId.Close()
 
-	cs.mtx.Lock()
-	defer cs.mtx.Unlock()
+	if err := fileBuf.Err(); err != nil {
+		log.Fatal(fileName, ": ", err)
+	}
+	if err := storageWriter.Sync(); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.Rename(fileName, fileName+".imported"); err != nil {
+		log.Fatal(err)
+	}
 
-	toUpdateVal := KeyValueConfig.KeyValues[keyVal]
+	fmt.Println("Storage File:", fileName, imported, "Lines Imported into the Storage Log,", skipped, "Skipped")
 
-	return toUpdateVal
 }
 
 //---------------------------------------------------------------------------//
 
-func InitializeCluster(replicaInitMsg cassandra.InitReplicaCluster) {
+//Check the Storage Log on a Schedule, and Compact it Once it Has Outgrown its Last Compacted Size
+func StorageCompactor(storageWriter *Storage.CommitLog) {
 
-	allReplicas := replicaInitMsg.GetAllReplica()
+	if storageCompactionInterval <= 0 {
+		return
+	}
 
-	for _, thisReplica := range allReplicas {
+	compactedSize := int64(0)
 
-		//If details of Same Replica
-		if thisReplica.Name == myConfig.Name {
+	for {
 
-			myConfig.IP = thisReplica.Ip
-			myConfig.Port = thisReplica.Port
+		time.Sleep(storageCompactionInterval)
 
-		} else {
+		size := storageWriter.Size()
+		if size < max(storageCompactionMin, storageCompactionRatio*compactedSize) {
+			continue
+		}
 
-			newReplica := new(replica)
-			newReplica.Name = thisReplica.Name
-			newReplica.IP = thisReplica.Ip
-			newReplica.Port = thisReplica.Port
-			newReplica.TCPAddress, _ = net.ResolveTCPAddr("tcp", newReplica.IP+":"+newReplica.Port)
+		if err := CompactStorageLog(storageWriter); err != nil {
+			fmt.Println("Storage Log Compaction Failed:", err)
+			continue
+		}
 
-			//Add it to the Cluster Configuration
-			myReplicaCluster[newReplica.Name] = *newReplica
+		compactedSize = storageWriter.Size()
+		fmt.Println("Storage Log Compacted:", storageWriter.Path, size, "Bytes to", compactedSize, "Bytes")
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Rewrite the Storage Log from the Engine, which Has the Latest Version of Every Row. Writes Go On Meanwhile
+func CompactStorageLog(storageWriter *Storage.CommitLog) error {
 
+	return storageWriter.Compact(func(write func(storageKey string, row Storage.Row) error) error {
+
+		var writeErr error
+		err := KeyValueConfig.Engine.Scan(nil, nil, func(storageKey []byte, row Storage.Row) bool {
+			writeErr = write(string(storageKey), row)
+			return writeErr == nil
+		})
+		if err == nil {
+			err = writeErr
 		}
 
-		replicaNames = append(replicaNames, thisReplica.Name)
+		return err
+	}, compactionThrottle)
 
-	}
+}
 
-	fmt.Println(myConfig.Name, "Initialized From Client Request")
+//---------------------------------------------------------------------------//
 
+//gRPC Front-End; Requests Go Through the Same Handlers as the Socket Protocol
+type replicaService struct {
+	storageWriter *Storage.CommitLog
+}
+
+//Captures the First Response a Handler Sends for One gRPC Call
+type grpcReply struct {
+	respChan chan *cassandra.Response
 }
 
 //---------------------------------------------------------------------------//
 
-func ClusterSetup(replicaConfigFile string) {
+func ServeGrpc(storageWriter *Storage.CommitLog) {
 
-	file, err := os.Open("../Client/" + replicaConfigFile)
+	grpcListener, err := net.Listen("tcp", myConfig.IP+":"+myConfig.GrpcPort)
 	if err != nil {
-		fmt.Printf("error opening file: %v\n", err)
-		os.Exit(1)
+		log.Fatal(err)
 	}
 
-	//Read Config File Content
-	fileContent := bufio.NewReader(file)
-	fileBuff, _, err := fileContent.ReadLine()
+	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(int(Transport.MaxMessageSize)), grpc.MaxSendMsgSize(int(Transport.MaxMessageSize)))
+	cassandra.RegisterReplicaServer(grpcServer, &replicaService{storageWriter: storageWriter})
 
-	for err == nil {
+	fmt.Println(myConfig.Name, "gRPC API Listening on Port", myConfig.GrpcPort)
 
-		replicaLine := string(fileBuff)
-		replicaDtl := strings.Split(replicaLine, " ")
+	if err := grpcServer.Serve(grpcListener); err != nil {
+		fmt.Println("gRPC: Server Stopped.!", err)
+	}
 
-		//If details of Same Replica
-		if replicaDtl[0] == myConfig.Name {
+}
 
-			myConfig.IP = replicaDtl[1]
-			myConfig.Port = replicaDtl[2]
+//---------------------------------------------------------------------------//
 
-		} else {
+func (gr *grpcReply) Send(msg *cassandra.InputRequest) error {
 
-			newReplica := new(replica)
-			newReplica.Name = replicaDtl[0]
-			newReplica.IP = replicaDtl[1]
-			newReplica.Port = replicaDtl[2]
-			newReplica.TCPAddress, _ = net.ResolveTCPAddr("tcp", newReplica.IP+":"+newReplica.Port)
+	select {
+	case gr.respChan <- msg.GetResponse():
+	default: //Later Responses for the Same Call are Dropped
+	}
 
-			//Add it to the Cluster Configuration
-			myReplicaCluster[newReplica.Name] = *newReplica
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Run the Request Through ProcessRequest and Wait for its Response or the Deadline
+func (rs *replicaService) call(ctx context.Context, requestMsg *cassandra.InputRequest) (*cassandra.Response, error) {
 
+	if !replicaInitialized.Load() {
+		return nil, status.Error(codes.FailedPrecondition, "Replica Not Initialized. Request Cannot be processed.")
+	}
+
+	reply := new(grpcReply)
+	reply.respChan = make(chan *cassandra.Response, 1)
+	done := make(chan bool)
+
+	go func() {
+		ProcessRequest(requestMsg, reply, rs.storageWriter)
+		close(done)
+	}()
+
+	select {
+	case resp := <-reply.respChan:
+		return GrpcResult(resp)
+	case <-done:
+		select {
+		case resp := <-reply.respChan:
+			return GrpcResult(resp)
+		default:
+			return nil, status.Error(codes.Unavailable, "Not Enough Replicas Acknowledged the Request.!")
 		}
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
 
-		replicaNames = append(replicaNames, replicaDtl[0])
+}
 
-		fileBuff, _, err = fileContent.ReadLine()
+//---------------------------------------------------------------------------//
+
+func (rs *replicaService) InitCluster(ctx context.Context, initMsg *cassandra.InitReplicaCluster) (*cassandra.Response, error) {
 
+	if !InitializeReplica(initMsg) {
+		return nil, status.Error(codes.AlreadyExists, "Replica already Initialized.")
 	}
 
-	fmt.Println(myConfig.Name, "Initialized Using Configure File")
+	initResponse := new(cassandra.Response)
+	initResponse.OriginReplica = myConfig.Name
+	initResponse.Status = true
+	initResponse.RespMessage = myConfig.Name + " Initialized.!"
 
+	return initResponse, nil
 }
 
 //---------------------------------------------------------------------------//
 
-func ByteOrderPartition() {
+func (rs *replicaService) CreateKeyspace(ctx context.Context, keyspaceMsg *cassandra.KeyspaceDefinition) (*cassandra.Response, error) {
 
-	for i := 0; i <= 255; i++ {
+	if !replicaInitialized.Load() {
+		return nil, status.Error(codes.FailedPrecondition, "Replica Not Initialized. Request Cannot be processed.")
+	}
 
-		newKeyValueConfig := new(keyConfig)
+	return GrpcResult(ProcessCreateKeyspaceRequest(keyspaceMsg))
+}
 
-		if i <= 63 {
+//---------------------------------------------------------------------------//
 
-			newKeyValueConfig.ReplicaAssigned1 = replicaNames[0]
-			newKeyValueConfig.ReplicaAssigned2 = replicaNames[1]
-			newKeyValueConfig.ReplicaAssigned3 = replicaNames[2]
+func (rs *replicaService) Put(ctx context.Context, clientPutMsg *cassandra.ClientPut) (*cassandra.Response, error) {
 
-		} else if (i >= 64) && (i <= 127) {
+	requestMsg := new(cassandra.InputRequest)
+	requestMsg.InputRequest = &cassandra.InputRequest_ClientPut{ClientPut: clientPutMsg}
 
-			newKeyValueConfig.ReplicaAssigned1 = replicaNames[1]
-			newKeyValueConfig.ReplicaAssigned2 = replicaNames[2]
-			newKeyValueConfig.ReplicaAssigned3 = replicaNames[3]
+	return rs.call(ctx, requestMsg)
+}
 
-		} else if (i >= 128) && (i <= 191) {
+//---------------------------------------------------------------------------//
 
-			newKeyValueConfig.ReplicaAssigned1 = replicaNames[2]
-			newKeyValueConfig.ReplicaAssigned2 = replicaNames[3]
-			newKeyValueConfig.ReplicaAssigned3 = replicaNames[0]
+func (rs *replicaService) Get(ctx context.Context, clientReadMsg *cassandra.ClientRead) (*cassandra.Response, error) {
 
-		} else {
+	requestMsg := new(cassandra.InputRequest)
+	requestMsg.InputRequest = &cassandra.InputRequest_ClientRead{ClientRead: clientReadMsg}
 
-			newKeyValueConfig.ReplicaAssigned1 = replicaNames[3]
-			newKeyValueConfig.ReplicaAssigned2 = replicaNames[0]
-			newKeyValueConfig.ReplicaAssigned3 = replicaNames[1]
+	return rs.call(ctx, requestMsg)
+}
 
-		}
+//---------------------------------------------------------------------------//
 
-		newKeyValueConfig.MyValue = ""
+func (rs *replicaService) ReplicaPut(ctx context.Context, replicaPutMsg *cassandra.ReplicaPut) (*cassandra.Response, error) {
 
-		//Add it the Replica Mapping
-		KeyValueConfig.KeyValues[uint32(i)] = *newKeyValueConfig
+	if replicaPutMsg.GetInput() == nil {
+		return nil, status.Error(codes.InvalidArgument, "ReplicaPut Without Input.")
 	}
 
+	//Answered with the Replica's Acknowledgement
+	requestMsg := new(cassandra.InputRequest)
+	requestMsg.InputRequest = &cassandra.InputRequest_ReplicaPut{ReplicaPut: replicaPutMsg}
+
+	return rs.call(ctx, requestMsg)
 }
 
 //---------------------------------------------------------------------------//
 
-func WriteToStorage(putMsg *cassandra.RequestParameter, storageWriter *bufio.Writer) {
+func (rs *replicaService) ReplicaRead(ctx context.Context, replicaReadMsg *cassandra.ReplicaRead) (*cassandra.Response, error) {
+
+	requestMsg := new(cassandra.InputRequest)
+	requestMsg.InputRequest = &cassandra.InputRequest_ReplicaRead{ReplicaRead: replicaReadMsg}
+
+	return rs.call(ctx, requestMsg)
+}
+
+//---------------------------------------------------------------------------//
 
-	//Format String
-	data := fmt.Sprint(putMsg.GetKey()) + separator +
-		putMsg.GetValue() + separator +
-		fmt.Sprint(putMsg.GetTimeInSeconds()) + "\n"
+func (rs *replicaService) Repair(ctx context.Context, repairMsg *cassandra.RepairRequest) (*cassandra.Response, error) {
 
-	//Write it to File
-	storageWriter.WriteString(data)
-	storageWriter.Flush()
+	if !replicaInitialized.Load() {
+		return nil, status.Error(codes.FailedPrecondition, "Replica Not Initialized. Request Cannot be processed.")
+	}
 
+	return GrpcResult(ProcessRepairRequest(repairMsg, rs.storageWriter))
 }
 
 //---------------------------------------------------------------------------//
 
-func ReloadValue(fileName string) {
+func (rs *replicaService) Decommission(ctx context.Context, decommissionMsg *cassandra.DecommissionRequest) (*cassandra.Response, error) {
 
-	fileId, err := os.Open(fileName)
+	if !replicaInitialized.Load() {
+		return nil, status.Error(codes.FailedPrecondition, "Replica Not Initialized. Request Cannot be processed.")
+	}
 
-	//File Not Found, Exit
-	if err != nil {
-		log.Fatal(err)
+	return GrpcResult(ProcessDecommissionRequest())
+}
+
+//---------------------------------------------------------------------------//
+
+func (rs *replicaService) Scan(scanReq *cassandra.ScanRequest, stream cassandra.Replica_ScanServer) error {
+
+	if !replicaInitialized.Load() {
+		return status.Error(codes.FailedPrecondition, "Replica Not Initialized. Request Cannot be processed.")
 	}
 
-	//Read Content
-	fileBuf := bufio.NewReader(fileId)
-	fileContent, _, err := fileBuf.ReadLine()
+	//An Empty End Key Leaves the Range Open
+	if len(scanReq.GetEndKey()) > 0 && bytes.Compare(scanReq.GetStartKey(), scanReq.GetEndKey()) > 0 {
+		return status.Error(codes.InvalidArgument, "Start Key is Greater than End Key.")
+	}
 
-	for err == nil {
+	keyspace := KeyspaceName(scanReq.GetKeyspace())
+	if !KeyspaceConfig.Exists(keyspace) {
+		return status.Error(codes.NotFound, "Keyspace "+keyspace+" Does Not Exist.")
+	}
+
+	//Snapshot the Rows of the Keyspace in Range (Byte Order) that Hold a Value
+	scanKeys := [][]byte{}
+	scanRows := []keyConfig{}
+	err := KeyValueConfig.ScanRows(keyspace, scanReq.GetStartKey(), scanReq.GetEndKey(), func(key []byte, keyValues keyConfig) bool {
+		if keyValues.MyValue != "" {
+			scanKeys = append(scanKeys, key)
+			scanRows = append(scanRows, keyValues)
+		}
+		return true
+	})
+	if err != nil {
+		return status.Error(codes.Internal, "Storage Engine Error: "+err.Error())
+	}
 
-		eachLine := string(fileContent)
-		data := strings.Split(eachLine, separator)
+	for i, key := range scanKeys {
 
-		//Save Value in In-Memory
-		key, _ := strconv.Atoi(data[0])
-		timeVal, _ := strconv.Atoi(data[2])
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
 
-		updateKeyValue := KeyValueConfig.KeyValues[uint32(key)]
+		keyValues := scanRows[i]
 
-		//Load the Latest Value
-		if int64(timeVal) > updateKeyValue.Arrived {
-			updateKeyValue.MyValue = data[1]
-			updateKeyValue.Arrived = int64(timeVal)
-			KeyValueConfig.KeyValues[uint32(key)] = updateKeyValue
+		scanResponse := new(cassandra.Response)
+		scanResponse.OriginReplica = myConfig.Name
+		scanResponse.Key = key
+		scanResponse.Value = keyValues.MyValue
+		scanResponse.Arrival = keyValues.Arrived
+		scanResponse.Status = true
 
-			fmt.Println(key, KeyValueConfig.KeyValues[uint32(key)].MyValue, KeyValueConfig.KeyValues[uint32(key)].Arrived)
+		if err := stream.Send(scanResponse); err != nil {
+			return err
 		}
 
-		fileContent, _, err = fileBuf.ReadLine()
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Failed Responses Become gRPC Status Errors
+func GrpcResult(resp *cassandra.Response) (*cassandra.Response, error) {
 
+	if resp.GetCode() != uint32(codes.OK) {
+		return nil, status.Error(codes.Code(resp.GetCode()), resp.GetRespMessage())
+	}
+
+	return resp, nil
+}
+
+//---------------------------------------------------------------------------//
+
+func DefaultGrpcPort(port string) string {
+
+	portNum, err := strconv.Atoi(port)
+	if err != nil {
+		return ""
 	}
 
+	return strconv.Itoa(portNum + grpcPortOffset)
 }
 
 //---------------------------------------------------------------------------//
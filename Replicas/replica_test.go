@@ -0,0 +1,586 @@
+package main
+
+import (
+	"../FailureDetector"
+	"../Hints"
+	"../Merkle"
+	"../Protobuf"
+	"../Ring"
+	"../Storage"
+	"../Transport"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+//---------------------------------------------------------------------------//
+
+//Storage Engine Fake: Rows in a Map, Versions Kept by the Engines' Rule, and Failures on Demand
+type fakeEngine struct {
+	mtx     sync.Mutex
+	rows    map[string]Storage.Row
+	getErr  error
+	putErr  error
+	scanErr error
+}
+
+//Responder Fake: Keeps Every Message a Handler Sends
+type fakeResponder struct {
+	mtx  sync.Mutex
+	sent []*cassandra.InputRequest
+}
+
+//---------------------------------------------------------------------------//
+
+func newFakeEngine() *fakeEngine {
+	return &fakeEngine{rows: make(map[string]Storage.Row)}
+}
+
+func (f *fakeEngine) Get(key []byte) (Storage.Row, error) {
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if f.getErr != nil {
+		return Storage.Row{}, f.getErr
+	}
+
+	return f.rows[string(key)], nil
+}
+
+func (f *fakeEngine) Put(key []byte, row Storage.Row) error {
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if f.putErr != nil {
+		return f.putErr
+	}
+
+	if current, found := f.rows[string(key)]; !found || row.Newer(current) {
+		f.rows[string(key)] = row
+	}
+
+	return nil
+}
+
+func (f *fakeEngine) Delete(key []byte, timestamp int64) error {
+	return f.Put(key, Storage.Row{Timestamp: timestamp, Tombstone: true})
+}
+
+func (f *fakeEngine) Scan(start []byte, end []byte, visit func(key []byte, row Storage.Row) bool) error {
+
+	f.mtx.Lock()
+	if f.scanErr != nil {
+		f.mtx.Unlock()
+		return f.scanErr
+	}
+	keys := []string{}
+	for key := range f.rows {
+		if Storage.InRange(key, start, end) {
+			keys = append(keys, key)
+		}
+	}
+	rows := make(map[string]Storage.Row)
+	for _, key := range keys {
+		rows[key] = f.rows[key]
+	}
+	f.mtx.Unlock()
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !visit([]byte(key), rows[key]) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeEngine) Flush() error { return nil }
+func (f *fakeEngine) Close() error { return nil }
+
+//---------------------------------------------------------------------------//
+
+func (f *fakeResponder) Send(msg *cassandra.InputRequest) error {
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.sent = append(f.sent, msg)
+
+	return nil
+}
+
+//The Only Response Sent; Fails the Test if there was Not Exactly One
+func (f *fakeResponder) response(t *testing.T) *cassandra.Response {
+
+	t.Helper()
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if len(f.sent) != 1 || f.sent[0].GetResponse() == nil {
+		t.Fatalf("Expected One Response, Got %v", f.sent)
+	}
+
+	return f.sent[0].GetResponse()
+}
+
+//---------------------------------------------------------------------------//
+
+//A Cluster of replicaNames on the Ring, this Process Being the First, with the Default Keyspace Held by
+//replicationFactor Replicas and its Rows in engine
+func setupReplica(t *testing.T, engine StorageEngine, replicationFactor int, replicaNames ...string) {
+
+	t.Helper()
+
+	myConfig = replica{Name: replicaNames[0], Datacenter: "dc1", Rack: "rack1"}
+	//Emptied in Place, as Hint Delivery from an Earlier Test may Still be Reading it
+	for _, eachReplica := range myReplicaCluster.All() {
+		myReplicaCluster.Remove(eachReplica.Name)
+	}
+	KeyValueConfig = criticalSection{Engine: engine}
+	KeyspaceConfig = keyspaceTable{Keyspaces: map[string]keyspaceSettings{defaultKeyspace: {ReplicationFactor: replicationFactor}}}
+	failureDetector = FailureDetector.New(FailureDetector.DefaultThreshold, time.Second)
+	readRepairMode = false
+	hintedHandOffMode = false
+
+	tokenRing = Ring.New(16)
+	for _, replicaName := range replicaNames {
+		tokenRing.AddReplica(replicaName)
+	}
+
+	store, err := Hints.Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	hintStore = store
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestClientWrite(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		consistency cassandra.RequestParameter_Consistency
+		putErr      error
+		status      bool
+		stored      bool
+	}{
+		{"One", cassandra.RequestParameter_ONE, nil, true, true},
+		{"All", cassandra.RequestParameter_ALL, nil, true, true},
+		{"Two Needs a Second Replica", cassandra.RequestParameter_TWO, nil, false, true},
+		{"Engine Failure", cassandra.RequestParameter_ONE, errors.New("disk full"), false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			engine := newFakeEngine()
+			engine.putErr = test.putErr
+			setupReplica(t, engine, 1, "Replica1")
+
+			putMsg := &cassandra.ClientPut{Input: &cassandra.RequestParameter{Key: []byte("k"), Value: "v", Keyspace: defaultKeyspace, Consistency: test.consistency}}
+			socket := new(fakeResponder)
+			ProcessClientPutRequest(putMsg, nil, socket)
+
+			if resp := socket.response(t); resp.GetStatus() != test.status {
+				t.Errorf("Status = %v (%s), Want %v", resp.GetStatus(), resp.GetRespMessage(), test.status)
+			}
+
+			row, _ := engine.Get([]byte(StorageKey(defaultKeyspace, []byte("k"))))
+			if stored := row.Value == "v"; stored != test.stored {
+				t.Errorf("Stored = %v, Want %v", stored, test.stored)
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestReplicaWrite(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		stored Storage.Row
+		put    Storage.Row
+		status bool
+		want   Storage.Row
+	}{
+		{"New Key", Storage.Row{}, Storage.Row{Value: "b", Timestamp: 10}, true, Storage.Row{Value: "b", Timestamp: 10}},
+		{"Newer Write", Storage.Row{Value: "a", Timestamp: 5}, Storage.Row{Value: "b", Timestamp: 10}, true, Storage.Row{Value: "b", Timestamp: 10}},
+		{"Older Write is Ignored", Storage.Row{Value: "a", Timestamp: 10}, Storage.Row{Value: "b", Timestamp: 5}, true, Storage.Row{Value: "a", Timestamp: 10}},
+		{"Equal Timestamps Keep the Greater Value", Storage.Row{Value: "b", Timestamp: 10}, Storage.Row{Value: "a", Timestamp: 10}, true, Storage.Row{Value: "b", Timestamp: 10}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			engine := newFakeEngine()
+			setupReplica(t, engine, 1, "Replica1")
+
+			storageKey := []byte(StorageKey(defaultKeyspace, []byte("k")))
+			if test.stored.Timestamp != 0 {
+				engine.Put(storageKey, test.stored)
+			}
+
+			putMsg := &cassandra.ReplicaPut{Input: &cassandra.RequestParameter{Key: []byte("k"), Value: test.put.Value, TimeInSeconds: test.put.Timestamp, Keyspace: defaultKeyspace}}
+			socket := new(fakeResponder)
+			ProcessReplicaPut(putMsg, socket, nil)
+
+			if resp := socket.response(t); resp.GetStatus() != test.status {
+				t.Errorf("Status = %v (%s), Want %v", resp.GetStatus(), resp.GetRespMessage(), test.status)
+			}
+
+			if row, _ := engine.Get(storageKey); row != test.want {
+				t.Errorf("Stored %+v, Want %+v", row, test.want)
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestClientRead(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		stored      string
+		consistency cassandra.ClientRead_Consistency
+		getErr      error
+		status      bool
+		value       string
+	}{
+		{"Found at ONE", "v", cassandra.ClientRead_ONE, nil, true, "v"},
+		{"Found at ALL", "v", cassandra.ClientRead_ALL, nil, true, "v"},
+		{"Found at LOCAL_QUORUM", "v", cassandra.ClientRead_LOCAL_QUORUM, nil, true, "v"},
+		{"Missing Key", "", cassandra.ClientRead_ONE, nil, false, ""},
+		{"Two Needs a Second Replica", "v", cassandra.ClientRead_TWO, nil, false, ""},
+		{"Engine Failure", "v", cassandra.ClientRead_ONE, errors.New("bad block"), false, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			engine := newFakeEngine()
+			setupReplica(t, engine, 1, "Replica1")
+			tokenRing.SetLocation("Replica1", "dc1", "rack1")
+
+			if test.stored != "" {
+				engine.Put([]byte(StorageKey(defaultKeyspace, []byte("k"))), Storage.Row{Value: test.stored, Timestamp: 10})
+			}
+			engine.getErr = test.getErr
+
+			socket := new(fakeResponder)
+			ProcessClientReadRequest(&cassandra.ClientRead{Key: []byte("k"), Keyspace: defaultKeyspace, Consistency: test.consistency}, socket)
+
+			resp := socket.response(t)
+			if resp.GetStatus() != test.status || resp.GetValue() != test.value {
+				t.Errorf("Got (%v, %q) %s, Want (%v, %q)", resp.GetStatus(), resp.GetValue(), resp.GetRespMessage(), test.status, test.value)
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Peer Fake for Repair: Answers Merkle Tree, Repair Rows and ReplicaPut Requests from its Own Rows
+type fakePeer struct {
+	mtx      sync.Mutex
+	rows     map[string]Storage.Row //Key -> Row, in the Default Keyspace
+	listener *net.TCPListener
+}
+
+//---------------------------------------------------------------------------//
+
+func startFakePeer(t *testing.T, rows map[string]Storage.Row) *fakePeer {
+
+	t.Helper()
+
+	listener, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	peer := &fakePeer{rows: rows, listener: listener}
+
+	go func() {
+		for {
+			conn, err := listener.AcceptTCP()
+			if err != nil {
+				return
+			}
+			go peer.serve(conn)
+		}
+	}()
+
+	return peer
+}
+
+//---------------------------------------------------------------------------//
+
+func (p *fakePeer) serve(conn *net.TCPConn) {
+
+	session := Transport.NewSession(conn)
+	defer session.Close()
+
+	for {
+
+		requestMsg := new(cassandra.InputRequest)
+		if err := session.Read(requestMsg); err != nil {
+			return
+		}
+
+		p.mtx.Lock()
+		respMsg := p.answer(requestMsg)
+		p.mtx.Unlock()
+
+		session.ReplyTo(requestMsg.GetRequestId()).Send(respMsg)
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Caller Holds the Lock
+func (p *fakePeer) answer(requestMsg *cassandra.InputRequest) *cassandra.InputRequest {
+
+	respMsg := new(cassandra.InputRequest)
+
+	switch {
+
+	case requestMsg.GetMerkleTreeRequest() != nil:
+		treeReq := requestMsg.GetMerkleTreeRequest()
+		tree := Merkle.New(treeReq.GetRange().GetStart(), treeReq.GetRange().GetEnd(), int(treeReq.GetDepth()))
+		for key, row := range p.rows {
+			tree.Add(Ring.KeyToken([]byte(key)), []byte(key), ValueDigest(row.Value, row.Timestamp))
+		}
+		tree.Build()
+		respMsg.InputRequest = &cassandra.InputRequest_MerkleTree{MerkleTree: &cassandra.MerkleTree{Range: treeReq.GetRange(), Depth: treeReq.GetDepth(), Hashes: tree.Hashes}}
+
+	case requestMsg.GetRepairRowsRequest() != nil:
+		rowsReq := requestMsg.GetRepairRowsRequest()
+		tree := Merkle.New(rowsReq.GetRange().GetStart(), rowsReq.GetRange().GetEnd(), int(rowsReq.GetDepth()))
+		rows := new(cassandra.RepairRows)
+		for key, row := range p.rows {
+			token := Ring.KeyToken([]byte(key))
+			for _, leaf := range rowsReq.GetLeaves() {
+				if Merkle.InRange(token, tree.Start, tree.End) && tree.Leaf(token) == int(leaf) {
+					rows.Rows = append(rows.Rows, &cassandra.RequestParameter{Key: []byte(key), Value: row.Value, TimeInSeconds: row.Timestamp})
+				}
+			}
+		}
+		respMsg.InputRequest = &cassandra.InputRequest_RepairRows{RepairRows: rows}
+
+	case requestMsg.GetReplicaPut() != nil:
+		input := requestMsg.GetReplicaPut().GetInput()
+		row := Storage.Row{Value: input.GetValue(), Timestamp: input.GetTimeInSeconds()}
+		if current, found := p.rows[string(input.GetKey())]; !found || row.Newer(current) {
+			p.rows[string(input.GetKey())] = row
+		}
+		respMsg.InputRequest = &cassandra.InputRequest_Response{Response: &cassandra.Response{Status: true}}
+
+	default:
+		respMsg.InputRequest = &cassandra.InputRequest_Response{Response: &cassandra.Response{RespMessage: "Unexpected Request"}}
+
+	}
+
+	return respMsg
+}
+
+//---------------------------------------------------------------------------//
+
+func TestRepair(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		local     map[string]Storage.Row
+		peer      map[string]Storage.Row
+		want      map[string]Storage.Row
+		transfers string //"Rows Received, Rows Sent" of the First Repair
+	}{
+		{
+			name:      "In Sync",
+			local:     map[string]Storage.Row{"a": {Value: "1", Timestamp: 10}},
+			peer:      map[string]Storage.Row{"a": {Value: "1", Timestamp: 10}},
+			want:      map[string]Storage.Row{"a": {Value: "1", Timestamp: 10}},
+			transfers: "0 Rows Received, 0 Rows Sent",
+		},
+		{
+			name:      "Missing on Either Side",
+			local:     map[string]Storage.Row{"a": {Value: "1", Timestamp: 10}},
+			peer:      map[string]Storage.Row{"b": {Value: "2", Timestamp: 10}},
+			want:      map[string]Storage.Row{"a": {Value: "1", Timestamp: 10}, "b": {Value: "2", Timestamp: 10}},
+			transfers: "1 Rows Received, 1 Rows Sent",
+		},
+		{
+			name:      "Newer Version Wins",
+			local:     map[string]Storage.Row{"a": {Value: "new", Timestamp: 20}, "b": {Value: "old", Timestamp: 10}},
+			peer:      map[string]Storage.Row{"a": {Value: "old", Timestamp: 10}, "b": {Value: "new", Timestamp: 20}},
+			want:      map[string]Storage.Row{"a": {Value: "new", Timestamp: 20}, "b": {Value: "new", Timestamp: 20}},
+			transfers: "1 Rows Received, 1 Rows Sent",
+		},
+		{
+			name:      "Equal Timestamps Converge on the Greater Value",
+			local:     map[string]Storage.Row{"a": {Value: "x", Timestamp: 10}, "b": {Value: "z", Timestamp: 10}},
+			peer:      map[string]Storage.Row{"a": {Value: "y", Timestamp: 10}, "b": {Value: "w", Timestamp: 10}},
+			want:      map[string]Storage.Row{"a": {Value: "y", Timestamp: 10}, "b": {Value: "z", Timestamp: 10}},
+			transfers: "1 Rows Received, 1 Rows Sent",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			engine := newFakeEngine()
+			setupReplica(t, engine, 2, "Replica1", "Replica2")
+
+			for key, row := range test.local {
+				engine.Put([]byte(StorageKey(defaultKeyspace, []byte(key))), row)
+			}
+
+			peer := startFakePeer(t, test.peer)
+			myReplicaCluster.Add(replica{Name: "Replica2", TCPAddress: peer.listener.Addr().(*net.TCPAddr)})
+
+			resp := ProcessRepairRequest(&cassandra.RepairRequest{Keyspace: defaultKeyspace}, nil)
+			if !resp.GetStatus() || !strings.Contains(resp.GetRespMessage(), test.transfers) {
+				t.Errorf("First Repair: %s, Want %s", resp.GetRespMessage(), test.transfers)
+			}
+
+			//Both Sides Now Agree, so Nothing is Left to Exchange
+			resp = ProcessRepairRequest(&cassandra.RepairRequest{Keyspace: defaultKeyspace}, nil)
+			if !resp.GetStatus() || !strings.Contains(resp.GetRespMessage(), "0 Rows Received, 0 Rows Sent") {
+				t.Errorf("Second Repair: %s", resp.GetRespMessage())
+			}
+
+			for key, want := range test.want {
+
+				if row, _ := engine.Get([]byte(StorageKey(defaultKeyspace, []byte(key)))); row != want {
+					t.Errorf("Local %s = %+v, Want %+v", key, row, want)
+				}
+
+				peer.mtx.Lock()
+				row := peer.rows[key]
+				peer.mtx.Unlock()
+				if row != want {
+					t.Errorf("Peer %s = %+v, Want %+v", key, row, want)
+				}
+
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestRepairScanFailure(t *testing.T) {
+
+	engine := newFakeEngine()
+	engine.scanErr = errors.New("bad block")
+	setupReplica(t, engine, 2, "Replica1", "Replica2")
+
+	resp := ProcessRepairRequest(&cassandra.RepairRequest{Keyspace: defaultKeyspace}, nil)
+	if resp.GetStatus() {
+		t.Errorf("Repair Succeeded Without its Rows: %s", resp.GetRespMessage())
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//The Storage Log is Rewritten from the Engine While Writes Keep Arriving: None of them is Lost, and
+//Replaying the Log Gives Back Exactly what the Engine Holds
+func TestStorageLogCompaction(t *testing.T) {
+
+	engine := Storage.NewMemory()
+	setupReplica(t, engine, 1, "Replica1")
+
+	options := Storage.DefaultOptions()
+	options.Sync = Storage.SyncGroup
+	options.GroupWindow = time.Millisecond
+	logPath := filepath.Join(t.TempDir(), "storage.log")
+
+	storageWriter, err := Storage.OpenCommitLog(logPath, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const writers, writes, keys = 4, 250, 50
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < writes; i++ {
+				putMsg := &cassandra.RequestParameter{Key: []byte(fmt.Sprint("k", i%keys)), Value: fmt.Sprint(w, "-", i), TimeInSeconds: int64(i), Keyspace: defaultKeyspace}
+				if err := WriteToStorage(putMsg, storageWriter); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	compactions := 0
+	for running := true; running; compactions++ {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		if err := CompactStorageLog(storageWriter); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := storageWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	replayedEngine := Storage.NewMemory()
+	replayed, truncated, err := Storage.RecoverCommitLog(logPath, func(storageKey string, row Storage.Row) error {
+		return replayedEngine.Put([]byte(storageKey), row)
+	})
+	if err != nil || truncated != 0 {
+		t.Fatalf("Recovery: %v, %d Bytes Cut", err, truncated)
+	}
+
+	//The Last Compaction Ran Once Every Write was In, so the Log Holds One Record per Key
+	if replayed != keys {
+		t.Errorf("%d Records Replayed After %d Compactions, Want %d", replayed, compactions, keys)
+	}
+
+	want, got := map[string]Storage.Row{}, map[string]Storage.Row{}
+	engine.Scan(nil, nil, func(key []byte, row Storage.Row) bool { want[string(key)] = row; return true })
+	replayedEngine.Scan(nil, nil, func(key []byte, row Storage.Row) bool { got[string(key)] = row; return true })
+	if len(want) != keys || fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Replayed Log %v, Engine %v", got, want)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
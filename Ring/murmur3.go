@@ -0,0 +1,100 @@
+package Ring
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+//---------------------------------------------------------------------------//
+
+//MurmurHash3 x64 128-bit Constants
+const murmurC1 = 0x87c37b91114253d5
+const murmurC2 = 0x4cf5ad432745937f
+
+//---------------------------------------------------------------------------//
+
+//MurmurHash3_x64_128 (Seed 0); the First 64 Bits are the Token, as in Cassandra's Murmur3Partitioner
+func Murmur3(data []byte) int64 {
+
+	var h1, h2 uint64
+
+	//Body - 16-Byte Blocks
+	nblocks := len(data) / 16
+	for i := 0; i < nblocks; i++ {
+
+		k1 := binary.LittleEndian.Uint64(data[i*16:])
+		k2 := binary.LittleEndian.Uint64(data[i*16+8:])
+
+		k1 *= murmurC1
+		k1 = bits.RotateLeft64(k1, 31)
+		k1 *= murmurC2
+		h1 ^= k1
+
+		h1 = bits.RotateLeft64(h1, 27)
+		h1 += h2
+		h1 = h1*5 + 0x52dce729
+
+		k2 *= murmurC2
+		k2 = bits.RotateLeft64(k2, 33)
+		k2 *= murmurC1
+		h2 ^= k2
+
+		h2 = bits.RotateLeft64(h2, 31)
+		h2 += h1
+		h2 = h2*5 + 0x38495ab5
+
+	}
+
+	//Tail - Remaining 0-15 Bytes
+	tail := data[nblocks*16:]
+	var k1, k2 uint64
+
+	for i := len(tail) - 1; i >= 8; i-- {
+		k2 ^= uint64(tail[i]) << (uint(i-8) * 8)
+	}
+	if len(tail) > 8 {
+		k2 *= murmurC2
+		k2 = bits.RotateLeft64(k2, 33)
+		k2 *= murmurC1
+		h2 ^= k2
+	}
+
+	for i := min(len(tail), 8) - 1; i >= 0; i-- {
+		k1 ^= uint64(tail[i]) << (uint(i) * 8)
+	}
+	if len(tail) > 0 {
+		k1 *= murmurC1
+		k1 = bits.RotateLeft64(k1, 31)
+		k1 *= murmurC2
+		h1 ^= k1
+	}
+
+	//Finalization
+	h1 ^= uint64(len(data))
+	h2 ^= uint64(len(data))
+
+	h1 += h2
+	h2 += h1
+
+	h1 = fmix64(h1)
+	h2 = fmix64(h2)
+
+	h1 += h2
+
+	return int64(h1)
+}
+
+//---------------------------------------------------------------------------//
+
+func fmix64(k uint64) uint64 {
+
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+
+	return k
+}
+
+//---------------------------------------------------------------------------//
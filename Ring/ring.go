@@ -0,0 +1,243 @@
+package Ring
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const DefaultVnodes = 16
+
+//One Virtual Node - A Token Owned by a Replica
+type vnode struct {
+	Token   int64
+	Replica string
+}
+
+//Token Range (Start, End] and the Replicas Holding it; Start == End Covers the Whole Ring
+type Range struct {
+	Start    int64
+	End      int64
+	Replicas []string
+}
+
+//Consistent-Hash Token Ring; Each Replica Owns Several Tokens (Virtual Nodes)
+type Ring struct {
+	Vnodes int
+
+	mtx       sync.RWMutex
+	vnodes    []vnode //Sorted by Token
+	owners    map[string][]int64
+	locations map[string]Location //Kept for Replicas Off the Ring Too, e.g. While JOINING
+}
+
+//---------------------------------------------------------------------------//
+
+func New(vnodes int) *Ring {
+
+	if vnodes < 1 {
+		vnodes = DefaultVnodes
+	}
+
+	ring := new(Ring)
+	ring.Vnodes = vnodes
+	ring.owners = make(map[string][]int64)
+	ring.locations = make(map[string]Location)
+
+	return ring
+}
+
+//---------------------------------------------------------------------------//
+
+//Token of a Key
+func KeyToken(key []byte) int64 {
+	return Murmur3(key)
+}
+
+//---------------------------------------------------------------------------//
+
+//Tokens Derived from the Replica Name, so Every Node Computes the Same Ring
+func ReplicaTokens(replicaName string, vnodes int) []int64 {
+
+	tokens := make([]int64, 0, vnodes)
+	for i := 0; i < vnodes; i++ {
+		tokens = append(tokens, Murmur3([]byte(replicaName+"#"+strconv.Itoa(i))))
+	}
+
+	return tokens
+}
+
+//---------------------------------------------------------------------------//
+
+//Copy of the Ring, to Work Out Ownership Before the Ring Changes
+func (r *Ring) Clone() *Ring {
+
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	clone := New(r.Vnodes)
+	for replicaName, tokens := range r.owners {
+		clone.owners[replicaName] = append([]int64{}, tokens...)
+	}
+	for replicaName, location := range r.locations {
+		clone.locations[replicaName] = location
+	}
+	clone.rebuild()
+
+	return clone
+}
+
+//---------------------------------------------------------------------------//
+
+//Add a Replica with its Default Tokens
+func (r *Ring) AddReplica(replicaName string) {
+	r.SetTokens(replicaName, ReplicaTokens(replicaName, r.Vnodes))
+}
+
+//---------------------------------------------------------------------------//
+
+//Place a Replica on the Ring at the Given Tokens (Replacing any it Held)
+func (r *Ring) SetTokens(replicaName string, tokens []int64) {
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.owners[replicaName] = append([]int64{}, tokens...)
+	r.rebuild()
+
+}
+
+//---------------------------------------------------------------------------//
+
+func (r *Ring) RemoveReplica(replicaName string) {
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	delete(r.owners, replicaName)
+	r.rebuild()
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Re-Sort the Virtual Nodes; Caller Holds the Lock
+func (r *Ring) rebuild() {
+
+	r.vnodes = r.vnodes[:0]
+	for replicaName, tokens := range r.owners {
+		for _, token := range tokens {
+			r.vnodes = append(r.vnodes, vnode{Token: token, Replica: replicaName})
+		}
+	}
+
+	//Ties (Practically Never) are Broken by Name so all Nodes Agree
+	sort.Slice(r.vnodes, func(i, j int) bool {
+		if r.vnodes[i].Token != r.vnodes[j].Token {
+			return r.vnodes[i].Token < r.vnodes[j].Token
+		}
+		return r.vnodes[i].Replica < r.vnodes[j].Replica
+	})
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Replicas Responsible for a Key: Walk Clockwise from the Key's Token, Collecting
+//Distinct Replicas Until the Strategy is Satisfied
+func (r *Ring) PreferenceList(key []byte, strategy Strategy) []string {
+	return r.TokenPreferenceList(KeyToken(key), strategy)
+}
+
+//---------------------------------------------------------------------------//
+
+func (r *Ring) TokenPreferenceList(token int64, strategy Strategy) []string {
+
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	replicas := []string{}
+	if len(r.vnodes) == 0 {
+		return replicas
+	}
+
+	//First Virtual Node with Token >= Key Token (Wrapping Around)
+	start := sort.Search(len(r.vnodes), func(i int) bool { return r.vnodes[i].Token >= token })
+
+	if len(strategy.Datacenters) > 0 {
+		return r.topologyWalk(start, strategy.Datacenters)
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < len(r.vnodes) && len(replicas) < strategy.ReplicationFactor; i++ {
+
+		owner := r.vnodes[(start+i)%len(r.vnodes)].Replica
+		if !seen[owner] {
+			seen[owner] = true
+			replicas = append(replicas, owner)
+		}
+
+	}
+
+	return replicas
+}
+
+//---------------------------------------------------------------------------//
+
+func (r *Ring) Replicas() []string {
+
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	replicas := make([]string, 0, len(r.owners))
+	for replicaName := range r.owners {
+		replicas = append(replicas, replicaName)
+	}
+	sort.Strings(replicas)
+
+	return replicas
+}
+
+//---------------------------------------------------------------------------//
+
+func (r *Ring) Tokens(replicaName string) []int64 {
+
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return append([]int64{}, r.owners[replicaName]...)
+}
+
+//---------------------------------------------------------------------------//
+
+//Every Range Between Adjacent Tokens with its Replicas - Keys in (Previous Token, Token]
+//Belong to the Same Replicas as the Token Itself
+func (r *Ring) Ranges(strategy Strategy) []Range {
+
+	r.mtx.RLock()
+	vnodes := append([]vnode{}, r.vnodes...)
+	r.mtx.RUnlock()
+
+	ranges := []Range{}
+	for i, eachVnode := range vnodes {
+
+		previous := vnodes[(i+len(vnodes)-1)%len(vnodes)].Token
+
+		//Duplicate Tokens Leave an Empty Range
+		if previous == eachVnode.Token && len(vnodes) > 1 {
+			continue
+		}
+
+		tokenRange := Range{Start: previous, End: eachVnode.Token}
+		tokenRange.Replicas = r.TokenPreferenceList(eachVnode.Token, strategy)
+		ranges = append(ranges, tokenRange)
+
+	}
+
+	return ranges
+}
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,249 @@
+package Ring
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const DefaultDatacenter = "dc1"
+const DefaultRack = "rack1"
+
+//Where a Replica Runs - Told by the Snitch (Cluster Config File or Gossip)
+type Location struct {
+	Datacenter string
+	Rack       string
+}
+
+//How a Keyspace Places its Replicas. SimpleStrategy: the Next ReplicationFactor Distinct Replicas
+//Clockwise. NetworkTopologyStrategy (Datacenters Set): a Replication Factor per Datacenter, Spread Across Racks
+type Strategy struct {
+	ReplicationFactor int
+	Datacenters       map[string]int
+}
+
+//---------------------------------------------------------------------------//
+
+func SimpleStrategy(replicationFactor int) Strategy {
+	return Strategy{ReplicationFactor: replicationFactor}
+}
+
+//---------------------------------------------------------------------------//
+
+//"dc1:3,dc2:2" - Replicas per Datacenter
+func ParseDatacenters(text string) (map[string]int, error) {
+
+	datacenters := make(map[string]int)
+
+	for _, entry := range strings.Split(text, ",") {
+
+		fields := strings.Split(strings.TrimSpace(entry), ":")
+		if len(fields) != 2 || fields[0] == "" {
+			return nil, errors.New("expected <datacenter>:<replicas>, got \"" + entry + "\"")
+		}
+
+		replicas, err := strconv.Atoi(fields[1])
+		if err != nil || replicas < 1 {
+			return nil, errors.New("datacenter " + fields[0] + " needs at least 1 replica")
+		}
+
+		if _, found := datacenters[fields[0]]; found {
+			return nil, errors.New("datacenter " + fields[0] + " given twice")
+		}
+		datacenters[fields[0]] = replicas
+
+	}
+
+	return datacenters, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//The Datacenters in ParseDatacenters Form, Sorted by Name
+func FormatDatacenters(datacenters map[string]int) string {
+
+	names := make([]string, 0, len(datacenters))
+	for name := range datacenters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	entries := []string{}
+	for _, name := range names {
+		entries = append(entries, name+":"+strconv.Itoa(datacenters[name]))
+	}
+
+	return strings.Join(entries, ",")
+}
+
+//---------------------------------------------------------------------------//
+
+//Replicas Holding Each Key - Summed over the Datacenters for NetworkTopologyStrategy
+func (s Strategy) Replicas() int {
+
+	if len(s.Datacenters) == 0 {
+		return s.ReplicationFactor
+	}
+
+	replicas := 0
+	for _, datacenterReplicas := range s.Datacenters {
+		replicas += datacenterReplicas
+	}
+
+	return replicas
+}
+
+//---------------------------------------------------------------------------//
+
+func (s Strategy) String() string {
+
+	if len(s.Datacenters) == 0 {
+		return "SimpleStrategy " + strconv.Itoa(s.ReplicationFactor)
+	}
+
+	return "NetworkTopologyStrategy " + FormatDatacenters(s.Datacenters)
+}
+
+//---------------------------------------------------------------------------//
+
+//Record Where a Replica Runs; Replicas Never Located Count as DefaultDatacenter / DefaultRack
+func (r *Ring) SetLocation(replicaName string, datacenter string, rack string) {
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if datacenter == "" {
+		datacenter = DefaultDatacenter
+	}
+	if rack == "" {
+		rack = DefaultRack
+	}
+
+	r.locations[replicaName] = Location{Datacenter: datacenter, Rack: rack}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func (r *Ring) Location(replicaName string) Location {
+
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.location(replicaName)
+}
+
+//---------------------------------------------------------------------------//
+
+//Replicas on the Ring in Each Datacenter
+func (r *Ring) DatacenterReplicas() map[string]int {
+
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	counts := make(map[string]int)
+	for replicaName := range r.owners {
+		counts[r.location(replicaName).Datacenter]++
+	}
+
+	return counts
+}
+
+//---------------------------------------------------------------------------//
+
+//Caller Holds the Lock
+func (r *Ring) location(replicaName string) Location {
+
+	if location, found := r.locations[replicaName]; found {
+		return location
+	}
+
+	return Location{Datacenter: DefaultDatacenter, Rack: DefaultRack}
+}
+
+//---------------------------------------------------------------------------//
+
+//NetworkTopologyStrategy Walk, Clockwise from Virtual Node start: Each Datacenter Takes Replicas Until its
+//Factor is Met, Preferring Racks it Has Not Used Yet. A Replica on a Used Rack Waits Until Every Rack of its
+//Datacenter is Used, then Fills the Rest in Ring Order. Caller Holds the Lock
+func (r *Ring) topologyWalk(start int, datacenters map[string]int) []string {
+
+	//Racks and Replicas of Each Datacenter; a Factor Beyond the Replicas Available is Capped
+	racks := make(map[string]map[string]bool)
+	available := make(map[string]int)
+	for replicaName := range r.owners {
+
+		location := r.location(replicaName)
+		if racks[location.Datacenter] == nil {
+			racks[location.Datacenter] = make(map[string]bool)
+		}
+		racks[location.Datacenter][location.Rack] = true
+		available[location.Datacenter]++
+
+	}
+
+	wanted := 0
+	for datacenter, datacenterReplicas := range datacenters {
+		wanted += min(datacenterReplicas, available[datacenter])
+	}
+
+	replicas := []string{}
+	seen := make(map[string]bool)
+	taken := make(map[string]int)
+	usedRacks := make(map[string]map[string]bool)
+	skipped := make(map[string][]string)
+
+	take := func(replicaName string, datacenter string) {
+		replicas = append(replicas, replicaName)
+		taken[datacenter]++
+	}
+
+	for i := 0; i < len(r.vnodes) && len(replicas) < wanted; i++ {
+
+		owner := r.vnodes[(start+i)%len(r.vnodes)].Replica
+		if seen[owner] {
+			continue
+		}
+		seen[owner] = true
+
+		location := r.location(owner)
+		datacenter := location.Datacenter
+		if taken[datacenter] >= datacenters[datacenter] {
+			continue
+		}
+
+		if usedRacks[datacenter] == nil {
+			usedRacks[datacenter] = make(map[string]bool)
+		}
+
+		switch {
+		case !usedRacks[datacenter][location.Rack]:
+
+			take(owner, datacenter)
+			usedRacks[datacenter][location.Rack] = true
+
+			//Every Rack Used - the Replicas Skipped Earlier Come Next
+			if len(usedRacks[datacenter]) == len(racks[datacenter]) {
+				for len(skipped[datacenter]) > 0 && taken[datacenter] < datacenters[datacenter] {
+					take(skipped[datacenter][0], datacenter)
+					skipped[datacenter] = skipped[datacenter][1:]
+				}
+			}
+
+		case len(usedRacks[datacenter]) == len(racks[datacenter]):
+			take(owner, datacenter)
+
+		default:
+			skipped[datacenter] = append(skipped[datacenter], owner)
+		}
+
+	}
+
+	return replicas
+}
+
+//---------------------------------------------------------------------------//
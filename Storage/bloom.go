@@ -0,0 +1,139 @@
+package Storage
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const DefaultFalsePositive = 0.01 //1 Lookup in 100 for an Absent Key Reads a Block Anyway
+const maxBloomHashes = 30
+
+//Bloom Filter over a Table's Keys: May Say a Missing Key is Present (at the Configured Rate), Never the
+//Reverse. Bit Positions Come from Two Halves of One 64-Bit FNV-1a Hash (Double Hashing)
+type bloomFilter struct {
+	hashes int
+	bits   []byte
+}
+
+//---------------------------------------------------------------------------//
+
+func bloomHash(key string) uint64 {
+
+	h := fnv.New64a()
+	h.Write([]byte(key))
+
+	return h.Sum64()
+}
+
+//---------------------------------------------------------------------------//
+
+//Filter Sized for the Keys (Given as bloomHash Values) and the False Positive Rate; Nil if the Rate
+//Leaves Nothing to Filter
+func newBloomFilter(keyHashes []uint64, falsePositive float64) *bloomFilter {
+
+	if falsePositive <= 0 || falsePositive >= 1 || len(keyHashes) == 0 {
+		return nil
+	}
+
+	//Optimal Size: -n ln(p) / ln(2)^2 Bits, and (Bits / n) ln(2) Hashes
+	n := float64(len(keyHashes))
+	bitCount := max(int(math.Ceil(-n*math.Log(falsePositive)/(math.Ln2*math.Ln2))), 64)
+	hashes := min(max(int(math.Round(float64(bitCount)/n*math.Ln2)), 1), maxBloomHashes)
+
+	f := &bloomFilter{hashes: hashes, bits: make([]byte, (bitCount+7)/8)}
+	for _, hash := range keyHashes {
+		f.add(hash)
+	}
+
+	return f
+}
+
+//---------------------------------------------------------------------------//
+
+//Halves of a Key's Hash: the First Probe and the Step Between Probes. The Step is Made Odd - a Zero
+//Step Would Put Every Probe on the Same Bit
+func bloomProbes(hash uint64) (uint64, uint64) {
+	return hash & 0xffffffff, hash>>32 | 1
+}
+
+//---------------------------------------------------------------------------//
+
+func (f *bloomFilter) add(hash uint64) {
+
+	bitCount := uint64(len(f.bits)) * 8
+	h1, h2 := bloomProbes(hash)
+
+	for i := uint64(0); i < uint64(f.hashes); i++ {
+		bit := (h1 + i*h2) % bitCount
+		f.bits[bit/8] |= 1 << (bit % 8)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//False Only if the Key is Surely Absent; a Nil Filter Rules Nothing Out
+func (f *bloomFilter) mayContain(key string) bool {
+
+	if f == nil {
+		return true
+	}
+
+	bitCount := uint64(len(f.bits)) * 8
+	h1, h2 := bloomProbes(bloomHash(key))
+
+	for i := uint64(0); i < uint64(f.hashes); i++ {
+		bit := (h1 + i*h2) % bitCount
+		if f.bits[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+//---------------------------------------------------------------------------//
+
+//Uvarint Hash Count (0: No Filter), then Uvarint Bit-Array Length and the Bit Array
+func (f *bloomFilter) appendTo(buf []byte) []byte {
+
+	if f == nil {
+		return binary.AppendUvarint(buf, 0)
+	}
+
+	buf = binary.AppendUvarint(buf, uint64(f.hashes))
+	buf = binary.AppendUvarint(buf, uint64(len(f.bits)))
+
+	return append(buf, f.bits...)
+}
+
+//---------------------------------------------------------------------------//
+
+//Decode a Filter Written by appendTo; Returns the Bytes Read
+func readBloomFilter(buf []byte) (*bloomFilter, int, error) {
+
+	hashes, n := binary.Uvarint(buf)
+	if n <= 0 || hashes > maxBloomHashes {
+		return nil, 0, ErrCorruptTable
+	}
+	if hashes == 0 {
+		return nil, n, nil
+	}
+	pos := n
+
+	length, n := binary.Uvarint(buf[pos:])
+	if n <= 0 || length == 0 || uint64(len(buf)-pos-n) < length {
+		return nil, 0, ErrCorruptTable
+	}
+	pos += n
+
+	f := &bloomFilter{hashes: int(hashes), bits: buf[pos : pos+int(length)]}
+
+	return f, pos + int(length), nil
+}
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,206 @@
+package Storage
+
+import (
+	"fmt"
+	"math/bits"
+	"testing"
+)
+
+//---------------------------------------------------------------------------//
+
+func keyHashes(keys []string) []uint64 {
+
+	hashes := []uint64{}
+	for _, key := range keys {
+		hashes = append(hashes, bloomHash(key))
+	}
+
+	return hashes
+}
+
+func numberedKeys(prefix string, n int) []string {
+
+	keys := []string{}
+	for i := 0; i < n; i++ {
+		keys = append(keys, fmt.Sprintf("%s%08d", prefix, i))
+	}
+
+	return keys
+}
+
+//---------------------------------------------------------------------------//
+
+func TestBloomFilter(t *testing.T) {
+
+	const n, probes = 10000, 200000
+
+	tests := []struct {
+		falsePositive float64
+	}{
+		{0.1},
+		{DefaultFalsePositive},
+		{0.001},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.falsePositive), func(t *testing.T) {
+
+			present := numberedKeys("present", n)
+			f := newBloomFilter(keyHashes(present), test.falsePositive)
+
+			//No False Negatives
+			for _, key := range present {
+				if !f.mayContain(key) {
+					t.Fatalf("Added Key %s Ruled Out", key)
+				}
+			}
+
+			falsePositives := 0
+			for _, key := range numberedKeys("absent", probes) {
+				if f.mayContain(key) {
+					falsePositives++
+				}
+			}
+
+			rate := float64(falsePositives) / probes
+			if rate < test.falsePositive/2 || rate > test.falsePositive*1.5 {
+				t.Errorf("Measured False Positive Rate %.5f, Configured %.5f", rate, test.falsePositive)
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//A Hash with a Zero Upper Half Still Probes Distinct Bits
+func TestBloomFilterZeroStep(t *testing.T) {
+
+	f := newBloomFilter([]uint64{0x12345678}, DefaultFalsePositive)
+
+	set := 0
+	for _, b := range f.bits {
+		set += bits.OnesCount8(b)
+	}
+
+	if set != f.hashes {
+		t.Errorf("%d Bits Set by %d Probes", set, f.hashes)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestBloomFilterEncoding(t *testing.T) {
+
+	tests := []struct {
+		name          string
+		keys          []string
+		falsePositive float64
+		filtered      bool
+	}{
+		{"Filter", numberedKeys("key", 100), DefaultFalsePositive, true},
+		{"Rate of 0", numberedKeys("key", 100), 0, false},
+		{"Rate of 1", numberedKeys("key", 100), 1, false},
+		{"No Keys", nil, DefaultFalsePositive, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			f := newBloomFilter(keyHashes(test.keys), test.falsePositive)
+			if (f != nil) != test.filtered {
+				t.Fatalf("Filter Built: %v, Want %v", f != nil, test.filtered)
+			}
+
+			buf := append(f.appendTo(nil), "trailer"...)
+			decoded, n, err := readBloomFilter(buf)
+			if err != nil || string(buf[n:]) != "trailer" {
+				t.Fatalf("Decoded %d of %d Bytes: %v", n, len(buf), err)
+			}
+			if (decoded != nil) != test.filtered || (f != nil && (decoded.hashes != f.hashes || string(decoded.bits) != string(f.bits))) {
+				t.Errorf("Decoded %+v, Want %+v", decoded, f)
+			}
+
+		})
+	}
+
+	//Damage is Reported, Not Read Past
+	if _, _, err := readBloomFilter([]byte{maxBloomHashes + 1}); err != ErrCorruptTable {
+		t.Errorf("Too Many Hashes: %v", err)
+	}
+	if _, _, err := readBloomFilter([]byte{3, 10, 0xff}); err != ErrCorruptTable {
+		t.Errorf("Short Bit Array: %v", err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Point Reads from Tables Written With and Without a Filter
+func TestTableGet(t *testing.T) {
+
+	tests := []struct {
+		name          string
+		falsePositive float64
+		filtered      bool
+	}{
+		{"With a Filter", DefaultFalsePositive, true},
+		{"Without a Filter", 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			options := testOptions()
+			options.BlockSize = 256 //Many Blocks
+			options.FalsePositive = test.falsePositive
+
+			keys := numberedKeys("key", 1000)
+			entries := []entry{}
+			for i, key := range keys {
+				entries = append(entries, entry{key, Row{Value: fmt.Sprint("v", i), Timestamp: int64(i)}})
+			}
+
+			dir := t.TempDir()
+			written, err := writeTable(dir, 1, 0, newSliceIterator(entries), options, 0, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			written.close()
+
+			//Read Back from Disk
+			table, err := openTable(tableName(dir, 1), 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer table.close()
+
+			if (table.filter != nil) != test.filtered {
+				t.Fatalf("Filter Loaded: %v, Want %v", table.filter != nil, test.filtered)
+			}
+			if len(table.index) < 2 {
+				t.Fatalf("%d Blocks, Want Several", len(table.index))
+			}
+
+			for i, key := range keys {
+				row, found, err := table.get(key)
+				if err != nil || !found || row.Value != fmt.Sprint("v", i) {
+					t.Fatalf("%s = %+v, %v, %v", key, row, found, err)
+				}
+			}
+
+			//Absent Keys, Both Inside the Table's Key Range and Outside it
+			for _, key := range []string{"key00000000x", "key00000500x", "a", "zzz"} {
+				if _, found, err := table.get(key); err != nil || found {
+					t.Errorf("Absent Key %s: Found %v, %v", key, found, err)
+				}
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
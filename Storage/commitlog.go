@@ -0,0 +1,552 @@
+package Storage
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const logSuffix = ".log"
+const logHeaderSize = 8 //4-Byte Length + 4-Byte CRC32 of the Entry
+const maxLogEntrySize = 64 << 20
+
+const SyncPerWrite = "per-write" //Every Write is Synced Before it is Acknowledged
+const SyncGroup = "group"        //Writes Wait for a Shared Sync, Started a Group Window After the First of them
+const SyncPeriodic = "periodic"  //Writes are Acknowledged at Once and Synced Every Sync Period
+
+const DefaultSyncPeriod = 10 * time.Second
+const DefaultGroupWindow = 10 * time.Millisecond
+
+//Commit Log Errors
+var errCompactionRunning = errors.New("commit log compaction already running")
+
+//Append-Only, Binary Log of Writes. Each Record is Length-Prefixed and CRC-Checked, so Recovery Finds
+//Where a Crash Cut the Log Short. Appends are Serialized; when a Write Counts as Durable Depends on
+//the Sync Mode. The LSM Engine Keeps One Segment per Memtable; the Memory Engine's Replica Keeps One
+//Log for Good, and Compacts it
+type CommitLog struct {
+	Generation uint64 //LSM Segment Number; 0 for a Standalone Log
+	Path       string
+
+	options  Options
+	applying sync.RWMutex //Held Shared by Append Until its Write is Applied; a Compaction Starts its Tail Under it
+	mtx      sync.Mutex
+	synced   *sync.Cond //Broadcast Whenever a Sync Ends
+	file     *os.File
+	size     int64
+	appended uint64   //Records Written
+	durable  uint64   //Records Known to be on Disk
+	syncing  bool     //A Sync is Under Way - Others Wait for it
+	tail     [][]byte //Records Written During a Compaction; Nil when None Runs
+	err      error    //First Write or Sync Failure - the Log Takes No Writes After it
+	closed   bool
+	stop     chan struct{}
+	stopOnce sync.Once
+	syncer   sync.WaitGroup
+}
+
+//---------------------------------------------------------------------------//
+
+func validSync(mode string) bool {
+	return mode == SyncPerWrite || mode == SyncGroup || mode == SyncPeriodic
+}
+
+//---------------------------------------------------------------------------//
+
+func logName(dir string, generation uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("commitlog-%06d%s", generation, logSuffix))
+}
+
+//---------------------------------------------------------------------------//
+
+func createLog(dir string, generation uint64, options Options) (*CommitLog, error) {
+
+	l, err := OpenCommitLog(logName(dir, generation), options)
+	if err != nil {
+		return nil, err
+	}
+	l.Generation = generation
+
+	return l, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Open the Log for Appending, Creating it if Needed. Run RecoverCommitLog First, or Records Appended
+//After a Torn One are Lost to the Next Recovery
+func OpenCommitLog(path string, options Options) (*CommitLog, error) {
+
+	if !validSync(options.Sync) {
+		return nil, fmt.Errorf("unknown commit log sync mode %q - expected %s, %s or %s", options.Sync, SyncPerWrite, SyncGroup, SyncPeriodic)
+	}
+
+	fileId, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := fileId.Stat()
+	if err != nil {
+		fileId.Close()
+		return nil, err
+	}
+
+	//A New Log's Directory Entry Must Survive a Crash Too
+	if err := syncDir(filepath.Dir(path)); err != nil {
+		fileId.Close()
+		return nil, err
+	}
+
+	l := &CommitLog{Path: path, options: options, file: fileId, size: info.Size()}
+	l.synced = sync.NewCond(&l.mtx)
+	l.stop = make(chan struct{})
+
+	if options.Sync == SyncPeriodic && options.SyncPeriod > 0 {
+		l.syncer.Add(1)
+		go l.periodicSync()
+	}
+
+	return l, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Record: 4-Byte Big-Endian Entry Length, 4-Byte CRC32 of the Entry, Entry
+func encodeRecord(key string, row Row) []byte {
+
+	payload := appendEntry(nil, key, row)
+
+	record := make([]byte, logHeaderSize, logHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(payload))
+
+	return append(record, payload...)
+}
+
+//---------------------------------------------------------------------------//
+
+//Log the Write, then - Once it is as Durable as the Sync Mode Promises - apply it (May be Nil) to
+//Whatever the Log Backs. A Compaction Does Not Start its Tail Until apply Returns, so a Write Logged
+//Before the Tail is Always in the Compaction's Snapshot
+func (l *CommitLog) Append(key []byte, row Row, apply func() error) error {
+
+	l.applying.RLock()
+	defer l.applying.RUnlock()
+
+	sequence, err := l.write(string(key), row)
+	if err != nil {
+		return err
+	}
+
+	if err := l.commit(sequence); err != nil {
+		return err
+	}
+
+	if apply == nil {
+		return nil
+	}
+
+	return apply()
+}
+
+//---------------------------------------------------------------------------//
+
+//Write the Record (Not Yet Synced); Returns its Sequence Number for commit. A Record Recovery Would
+//Take for Damage is Refused
+func (l *CommitLog) write(key string, row Row) (uint64, error) {
+
+	record := encodeRecord(key, row)
+	if len(record)-logHeaderSize > maxLogEntrySize {
+		return 0, ErrEntryTooLarge
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.closed {
+		return 0, ErrClosed
+	}
+	if l.err != nil {
+		return 0, l.err
+	}
+
+	//A Short Write Leaves a Torn Record - Nothing May Follow it
+	if _, err := l.file.Write(record); err != nil {
+		l.err = err
+		return 0, err
+	}
+
+	l.size += int64(len(record))
+	l.appended++
+	if l.tail != nil {
+		l.tail = append(l.tail, record)
+	}
+
+	return l.appended, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Wait Until the Record is Durable as the Sync Mode Promises
+func (l *CommitLog) commit(sequence uint64) error {
+
+	switch l.options.Sync {
+	case SyncPerWrite:
+		return l.syncTo(sequence, 0)
+	case SyncGroup:
+		return l.syncTo(sequence, l.options.GroupWindow)
+	}
+
+	//Periodic - a Failed Background Sync Still Fails the Writes After it
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.err
+}
+
+//---------------------------------------------------------------------------//
+
+//Block Until Every Record up to sequence is on Disk. One Caller Syncs - After Waiting window, so
+//the Writes Arriving Meanwhile Share the Sync - and the Others Wait for it
+func (l *CommitLog) syncTo(sequence uint64, window time.Duration) error {
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	for l.durable < sequence {
+
+		if l.err != nil {
+			return l.err
+		}
+		if l.closed {
+			return ErrClosed
+		}
+		if l.syncing {
+			l.synced.Wait()
+			continue
+		}
+
+		l.syncing = true
+		l.mtx.Unlock()
+
+		if window > 0 {
+			time.Sleep(window)
+		}
+
+		l.mtx.Lock()
+		target := l.appended
+		fileId := l.file
+		l.mtx.Unlock()
+
+		err := fileId.Sync()
+
+		l.mtx.Lock()
+		l.syncing = false
+		if err != nil && l.err == nil {
+			l.err = err
+		} else if err == nil {
+			l.durable = max(l.durable, target)
+		}
+		l.synced.Broadcast()
+
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Make Every Record Written So Far Durable
+func (l *CommitLog) Sync() error {
+
+	l.mtx.Lock()
+	sequence := l.appended
+	l.mtx.Unlock()
+
+	return l.syncTo(sequence, 0)
+}
+
+//---------------------------------------------------------------------------//
+
+func (l *CommitLog) periodicSync() {
+
+	defer l.syncer.Done()
+
+	ticker := time.NewTicker(l.options.SyncPeriod)
+	defer ticker.Stop()
+
+	for {
+
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+		}
+
+		if err := l.Sync(); err != nil && err != ErrClosed {
+			fmt.Println("Commit Log:", l.Path, "Sync Failed -", err)
+		}
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Bytes in the Log File
+func (l *CommitLog) Size() int64 {
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.size
+}
+
+//---------------------------------------------------------------------------//
+
+//Sync, then Close the File
+func (l *CommitLog) Close() error {
+
+	l.stopOnce.Do(func() { close(l.stop) })
+	l.syncer.Wait()
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.closed {
+		return nil
+	}
+	for l.syncing {
+		l.synced.Wait()
+	}
+
+	err := l.err
+	if err == nil {
+		if err = l.file.Sync(); err == nil {
+			l.durable = l.appended
+		}
+	}
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+
+	l.closed = true
+	l.synced.Broadcast()
+
+	return err
+}
+
+//---------------------------------------------------------------------------//
+
+//Rewrite the Log as the Records snapshot Writes - the Latest Version of Each Key - and Swap it in.
+//Appends Go On Meanwhile and are Copied to the New Log Before the Swap; the Copy is Throttled.
+//snapshot Must Read What Append's apply Writes to
+func (l *CommitLog) Compact(snapshot func(write func(key string, row Row) error) error, throttle *Throttle) error {
+
+	//Appends Still Being Applied Finish First - Their Records Go Before the Tail
+	l.applying.Lock()
+	l.mtx.Lock()
+	switch {
+	case l.closed:
+		l.mtx.Unlock()
+		l.applying.Unlock()
+		return ErrClosed
+	case l.err != nil:
+		l.mtx.Unlock()
+		l.applying.Unlock()
+		return l.err
+	case l.tail != nil:
+		l.mtx.Unlock()
+		l.applying.Unlock()
+		return errCompactionRunning
+	}
+	l.tail = [][]byte{}
+	l.mtx.Unlock()
+	l.applying.Unlock()
+
+	//Caller Holds the Lock
+	tmpPath := l.Path + ".tmp"
+	fail := func(err error) error {
+		l.tail = nil
+		os.Remove(tmpPath)
+		return err
+	}
+
+	tmpId, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		l.mtx.Lock()
+		defer l.mtx.Unlock()
+		return fail(err)
+	}
+	tmpWriter := bufio.NewWriter(tmpId)
+
+	err = snapshot(func(key string, row Row) error {
+		record := encodeRecord(key, row)
+		throttle.Wait(len(record))
+		_, err := tmpWriter.Write(record)
+		return err
+	})
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if err == nil && l.closed {
+		err = ErrClosed
+	}
+	if err == nil && l.err != nil {
+		err = l.err
+	}
+	if err != nil {
+		tmpId.Close()
+		return fail(err)
+	}
+
+	//The Old File Must Not be Closed Under a Sync. Waiting Lets Go of the Lock, so it Comes Before
+	//the Tail is Taken - No Write Gets in Between from Here to the Swap
+	for l.syncing {
+		l.synced.Wait()
+	}
+
+	//Writes that Arrived During the Copy
+	for _, record := range l.tail {
+		if _, err := tmpWriter.Write(record); err != nil {
+			tmpId.Close()
+			return fail(err)
+		}
+	}
+	l.tail = nil
+
+	if err := tmpWriter.Flush(); err != nil {
+		tmpId.Close()
+		return fail(err)
+	}
+	if err := tmpId.Sync(); err != nil {
+		tmpId.Close()
+		return fail(err)
+	}
+	if err := tmpId.Close(); err != nil {
+		return fail(err)
+	}
+
+	if err := os.Rename(tmpPath, l.Path); err != nil {
+		return fail(err)
+	}
+
+	//From Here On the Old File is Gone - a Failure Stops the Log
+	fileId, err := os.OpenFile(l.Path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err == nil {
+		err = syncDir(filepath.Dir(l.Path))
+	}
+	if err != nil {
+		l.err = err
+		return err
+	}
+	info, err := fileId.Stat()
+	if err != nil {
+		fileId.Close()
+		l.err = err
+		return err
+	}
+
+	l.file.Close()
+	l.file = fileId
+	l.size = info.Size()
+
+	//The New File was Synced with Every Record Written
+	l.durable = l.appended
+	l.synced.Broadcast()
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Read the Log Back, Passing Each Record to visit (May be Nil) in Order. A Short or Damaged Record - a
+//Crash Mid-Append - Ends the Log: the File is Truncated There, so Appends Can Follow. Returns the
+//Records Read and the Bytes Cut Off. A Missing Log is Empty
+func RecoverCommitLog(path string, visit func(key string, row Row) error) (int, int64, error) {
+
+	fileId, err := os.OpenFile(path, os.O_RDWR, 0)
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	defer fileId.Close()
+
+	info, err := fileId.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	reader := bufio.NewReader(fileId)
+	replayed := 0
+	good := int64(0) //End of the Last Whole Record
+	header := make([]byte, logHeaderSize)
+
+	for {
+
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			return replayed, 0, err
+		}
+
+		size := binary.BigEndian.Uint32(header[0:4])
+		if size > maxLogEntrySize {
+			break
+		}
+
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			return replayed, 0, err
+		}
+
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:8]) {
+			break
+		}
+
+		key, row, n, err := readEntry(payload)
+		if err != nil || n != len(payload) {
+			break
+		}
+
+		if visit != nil {
+			if err := visit(key, row); err != nil {
+				return replayed, 0, err
+			}
+		}
+		replayed++
+		good += int64(logHeaderSize) + int64(size)
+
+	}
+
+	//Torn Tail
+	if good < info.Size() {
+		if err := fileId.Truncate(good); err != nil {
+			return replayed, 0, err
+		}
+		if err := fileId.Sync(); err != nil {
+			return replayed, 0, err
+		}
+	}
+
+	return replayed, info.Size() - good, nil
+}
+
+//---------------------------------------------------------------------------//
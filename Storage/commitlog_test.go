@@ -0,0 +1,414 @@
+package Storage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+//---------------------------------------------------------------------------//
+
+func openTestLog(t *testing.T, path string, options Options) *CommitLog {
+
+	t.Helper()
+
+	l, err := OpenCommitLog(path, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	return l
+}
+
+//---------------------------------------------------------------------------//
+
+func mustAppend(t *testing.T, l *CommitLog, key string, row Row) {
+
+	t.Helper()
+
+	if err := l.Append([]byte(key), row, nil); err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Every Record Recovery Replays, in Order, as "key=value@timestamp"
+func recoverAll(t *testing.T, path string) ([]string, int64) {
+
+	t.Helper()
+
+	replayed := []string{}
+	count, cut, err := RecoverCommitLog(path, func(key string, row Row) error {
+		replayed = append(replayed, formatRow(key, row))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != len(replayed) {
+		t.Fatalf("Recovery Counted %d Records, Visited %d", count, len(replayed))
+	}
+
+	return replayed, cut
+}
+
+//---------------------------------------------------------------------------//
+
+//Write a Log, Damage its Tail, Recover it: the Whole Records Before the Damage are Replayed and the
+//Rest is Cut Off, so Appends Can Follow
+func TestRecoverCommitLog(t *testing.T) {
+
+	records := [][]byte{
+		encodeRecord("a", Row{Value: "1", Timestamp: 1}),
+		encodeRecord("b", Row{Value: "2", Timestamp: 2}),
+		encodeRecord("c", Row{Timestamp: 3, Tombstone: true}),
+	}
+	whole := []byte{}
+	for _, record := range records {
+		whole = append(whole, record...)
+	}
+	last := len(whole) - len(records[2])
+
+	next := encodeRecord("d", Row{Value: "4", Timestamp: 4})
+
+	//A Record Whose CRC Matches a Payload that is Not an Entry
+	garbage := []byte{0xff, 0xff, 0xff}
+	malformed := binary.BigEndian.AppendUint32(binary.BigEndian.AppendUint32(nil, uint32(len(garbage))), crc32.ChecksumIEEE(garbage))
+	malformed = append(malformed, garbage...)
+
+	oversized := binary.BigEndian.AppendUint32(nil, maxLogEntrySize+1)
+	oversized = binary.BigEndian.AppendUint32(oversized, 0)
+
+	flip := func(at int) []byte {
+		damaged := append([]byte{}, whole...)
+		damaged[at] ^= 0xff
+		return damaged
+	}
+
+	tests := []struct {
+		name     string
+		contents []byte
+		replayed []string
+		cut      int64
+	}{
+		{"Intact", whole, []string{"a=1@1", "b=2@2", "c=-@3"}, 0},
+		{"Empty", nil, []string{}, 0},
+		{"Short Header", append(append([]byte{}, whole...), next[:logHeaderSize/2]...), []string{"a=1@1", "b=2@2", "c=-@3"}, logHeaderSize / 2},
+		{"Header Without a Payload", append(append([]byte{}, whole...), next[:logHeaderSize]...), []string{"a=1@1", "b=2@2", "c=-@3"}, logHeaderSize},
+		{"Short Payload", append(append([]byte{}, whole...), next[:len(next)-1]...), []string{"a=1@1", "b=2@2", "c=-@3"}, int64(len(next) - 1)},
+		{"CRC Mismatch in the Last Record", flip(len(whole) - 1), []string{"a=1@1", "b=2@2"}, int64(len(records[2]))},
+		{"CRC Mismatch Cuts Everything After it", flip(len(records[0]) + logHeaderSize), []string{"a=1@1"}, int64(len(whole) - len(records[0]))},
+		{"Damaged Length", flip(last), []string{"a=1@1", "b=2@2"}, int64(len(records[2]))},
+		{"Oversized Length", append(append([]byte{}, whole...), oversized...), []string{"a=1@1", "b=2@2", "c=-@3"}, int64(len(oversized))},
+		{"Malformed Entry", append(append([]byte{}, whole...), malformed...), []string{"a=1@1", "b=2@2", "c=-@3"}, int64(len(malformed))},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			path := filepath.Join(t.TempDir(), "test.log")
+			if err := os.WriteFile(path, test.contents, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			replayed, cut := recoverAll(t, path)
+			if fmt.Sprint(replayed) != fmt.Sprint(test.replayed) || cut != test.cut {
+				t.Errorf("Replayed %v, Cut %d Bytes - Want %v, %d", replayed, cut, test.replayed, test.cut)
+			}
+
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Size() != int64(len(test.contents))-test.cut {
+				t.Errorf("Log is %d Bytes After Recovery, Want %d", info.Size(), int64(len(test.contents))-test.cut)
+			}
+
+			//An Append After Recovery Follows the Last Whole Record
+			l := openTestLog(t, path, testOptions())
+			mustAppend(t, l, "e", Row{Value: "5", Timestamp: 5})
+			l.Close()
+
+			replayed, cut = recoverAll(t, path)
+			if want := append(test.replayed, "e=5@5"); fmt.Sprint(replayed) != fmt.Sprint(want) || cut != 0 {
+				t.Errorf("Second Recovery Replayed %v, Cut %d Bytes - Want %v, 0", replayed, cut, want)
+			}
+
+		})
+	}
+
+	//A Missing Log is Empty
+	if replayed, cut, err := RecoverCommitLog(filepath.Join(t.TempDir(), "missing.log"), nil); replayed != 0 || cut != 0 || err != nil {
+		t.Errorf("Missing Log: %d, %d, %v", replayed, cut, err)
+	}
+
+	//A visit Failure Ends the Recovery Without Cutting the Log
+	path := filepath.Join(t.TempDir(), "test.log")
+	os.WriteFile(path, whole, 0644)
+	visitErr := errors.New("engine full")
+	if _, _, err := RecoverCommitLog(path, func(key string, row Row) error { return visitErr }); err != visitErr {
+		t.Errorf("visit Failure: %v", err)
+	}
+	if info, _ := os.Stat(path); info.Size() != int64(len(whole)) {
+		t.Errorf("Log Cut to %d Bytes by a visit Failure", info.Size())
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//A Record Too Large for Recovery to Accept is Never Written
+func TestCommitLogEntryTooLarge(t *testing.T) {
+
+	l := openTestLog(t, filepath.Join(t.TempDir(), "test.log"), testOptions())
+
+	huge := string(make([]byte, maxLogEntrySize))
+	if err := l.Append([]byte("huge"), Row{Value: huge, Timestamp: 1}, nil); err != ErrEntryTooLarge {
+		t.Errorf("Oversized Append: %v, Want %v", err, ErrEntryTooLarge)
+	}
+	if l.Size() != 0 {
+		t.Errorf("Log is %d Bytes, Want 0", l.Size())
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestSyncModes(t *testing.T) {
+
+	const writers, writes = 8, 10
+
+	tests := []struct {
+		name       string
+		sync       string
+		period     time.Duration
+		window     time.Duration
+		maxElapsed time.Duration //Appends Can't Take Longer - Bounds How Often they Waited
+		durable    bool          //Every Append is Durable Once it Returns
+	}{
+		{"Per-Write", SyncPerWrite, 0, 0, 10 * time.Second, true},
+		{"Group Writes Share Syncs", SyncGroup, 0, 50 * time.Millisecond, writes * 50 * time.Millisecond * 2, true},
+		{"Periodic Writes Do Not Wait", SyncPeriodic, time.Hour, 0, time.Second, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			options := testOptions()
+			options.Sync = test.sync
+			options.SyncPeriod = test.period
+			options.GroupWindow = test.window
+			path := filepath.Join(t.TempDir(), "test.log")
+			l := openTestLog(t, path, options)
+
+			start := time.Now()
+			var wg sync.WaitGroup
+			for w := 0; w < writers; w++ {
+				wg.Add(1)
+				go func(w int) {
+					defer wg.Done()
+					for i := 0; i < writes; i++ {
+						if err := l.Append([]byte(fmt.Sprint(w, "-", i)), Row{Value: "v", Timestamp: 1}, nil); err != nil {
+							t.Error(err)
+							return
+						}
+					}
+				}(w)
+			}
+			wg.Wait()
+			elapsed := time.Since(start)
+
+			l.mtx.Lock()
+			appended, durable := l.appended, l.durable
+			l.mtx.Unlock()
+
+			if appended != writers*writes || (durable == appended) != test.durable {
+				t.Errorf("%d of %d Records Durable After the Appends", durable, appended)
+			}
+			if elapsed > test.maxElapsed {
+				t.Errorf("Appends Took %v, Want at Most %v", elapsed, test.maxElapsed)
+			}
+
+			//Sync Makes Everything Durable Whatever the Mode
+			if err := l.Sync(); err != nil {
+				t.Fatal(err)
+			}
+			if l.durable != l.appended {
+				t.Errorf("%d of %d Records Durable After Sync", l.durable, l.appended)
+			}
+
+			l.Close()
+			if replayed, _ := recoverAll(t, path); len(replayed) != writers*writes {
+				t.Errorf("%d Records Replayed, Want %d", len(replayed), writers*writes)
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//The Background Sync of the Periodic Mode Catches Up Without Any Write Waiting
+func TestPeriodicSync(t *testing.T) {
+
+	options := testOptions()
+	options.Sync = SyncPeriodic
+	options.SyncPeriod = 20 * time.Millisecond
+	l := openTestLog(t, filepath.Join(t.TempDir(), "test.log"), options)
+
+	mustAppend(t, l, "a", Row{Value: "1", Timestamp: 1})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		l.mtx.Lock()
+		durable := l.durable
+		l.mtx.Unlock()
+		if durable == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Periodic Sync Never Ran")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//A Closed Log Turns Away Appends and Syncs
+func TestCommitLogClose(t *testing.T) {
+
+	l := openTestLog(t, filepath.Join(t.TempDir(), "test.log"), testOptions())
+	mustAppend(t, l, "a", Row{Value: "1", Timestamp: 1})
+
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Append([]byte("b"), Row{Value: "2", Timestamp: 2}, nil); err != ErrClosed {
+		t.Errorf("Append After Close: %v, Want %v", err, ErrClosed)
+	}
+	if err := l.syncTo(5, 0); err != ErrClosed {
+		t.Errorf("Sync After Close: %v, Want %v", err, ErrClosed)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Appends Made While a Compaction Copies its Snapshot Land in the New Log After the Snapshot
+func TestCompactDuringAppends(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "test.log")
+	l := openTestLog(t, path, testOptions())
+	engine := NewMemory()
+
+	apply := func(key string, row Row) func() error {
+		return func() error { return engine.Put([]byte(key), row) }
+	}
+
+	//Overwritten Versions the Compaction Drops
+	for i := 1; i <= 3; i++ {
+		for _, key := range []string{"a", "b"} {
+			if err := l.Append([]byte(key), Row{Value: fmt.Sprint(key, i), Timestamp: int64(i)}, apply(key, Row{Value: fmt.Sprint(key, i), Timestamp: int64(i)})); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	before := l.Size()
+
+	err := l.Compact(func(write func(key string, row Row) error) error {
+
+		if err := engine.Scan(nil, nil, func(key []byte, row Row) bool { return write(string(key), row) == nil }); err != nil {
+			return err
+		}
+
+		//One Compaction at a Time
+		if err := l.Compact(func(func(string, Row) error) error { return nil }, nil); err != errCompactionRunning {
+			t.Errorf("Second Compaction: %v, Want %v", err, errCompactionRunning)
+		}
+
+		//Writers are Not Held Up by the Copy
+		done := make(chan error)
+		go func() {
+			for _, key := range []string{"b", "c"} {
+				row := Row{Value: fmt.Sprint(key, 4), Timestamp: 4}
+				if err := l.Append([]byte(key), row, apply(key, row)); err != nil {
+					done <- err
+					return
+				}
+			}
+			done <- nil
+		}()
+
+		return <-done
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if l.Size() >= before {
+		t.Errorf("Compacted Log is %d Bytes, Was %d", l.Size(), before)
+	}
+	if l.durable != l.appended {
+		t.Errorf("%d of %d Records Durable After Compaction", l.durable, l.appended)
+	}
+
+	//Appends Go On After the Swap
+	mustAppend(t, l, "d", Row{Value: "d5", Timestamp: 5})
+	l.Close()
+
+	replayed, cut := recoverAll(t, path)
+	if want := []string{"a=a3@3", "b=b3@3", "b=b4@4", "c=c4@4", "d=d5@5"}; fmt.Sprint(replayed) != fmt.Sprint(want) || cut != 0 {
+		t.Errorf("Replayed %v, Cut %d Bytes - Want %v, 0", replayed, cut, want)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//A Failed Compaction Leaves the Log as it Was, and Appending
+func TestCompactFailure(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "test.log")
+	l := openTestLog(t, path, testOptions())
+	mustAppend(t, l, "a", Row{Value: "1", Timestamp: 1})
+
+	snapshotErr := errors.New("scan failed")
+	if err := l.Compact(func(write func(key string, row Row) error) error {
+		write("a", Row{Value: "1", Timestamp: 1})
+		mustAppend(t, l, "b", Row{Value: "2", Timestamp: 2})
+		return snapshotErr
+	}, nil); err != snapshotErr {
+		t.Errorf("Compaction: %v, Want %v", err, snapshotErr)
+	}
+
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("Temporary Log Left Behind (%v)", err)
+	}
+
+	mustAppend(t, l, "c", Row{Value: "3", Timestamp: 3})
+	l.Close()
+
+	if err := l.Compact(func(func(string, Row) error) error { return nil }, nil); err != ErrClosed {
+		t.Errorf("Compaction After Close: %v, Want %v", err, ErrClosed)
+	}
+
+	replayed, _ := recoverAll(t, path)
+	if want := []string{"a=1@1", "b=2@2", "c=3@3"}; fmt.Sprint(replayed) != fmt.Sprint(want) {
+		t.Errorf("Replayed %v, Want %v", replayed, want)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,412 @@
+package Storage
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const CompactionSizeTiered = "size-tiered"
+const CompactionLeveled = "leveled"
+
+const minThreshold = 4        //Tables of a Size Tier (or in Level 0) that Start a Compaction
+const maxThreshold = 32       //Most Tables Merged by One Compaction
+const tierLow = 0.5           //Smallest Size, as a Fraction of a Tier's Average, a Table Joins the Tier With
+const tierHigh = 1.5          //Largest Size, as a Fraction of a Tier's Average, a Table Joins the Tier With
+const smallTierSize = 8 << 20 //Tables Below this Size All Share the Smallest Tier
+const levelMultiplier = 10    //Each Level Holds levelMultiplier Times the Bytes of the One Above
+const levelTables = 10        //Level 1 Holds levelTables Tables of the Target Size
+
+//Compaction Errors
+var errCompactionStopped = errors.New("compaction stopped: engine closing")
+
+//Tables Chosen to be Merged, and the Level the Output Goes to
+type compaction struct {
+	inputs []*sstable //Oldest First (by Generation)
+	level  int
+}
+
+//---------------------------------------------------------------------------//
+
+func validCompaction(strategy string) bool {
+	return strategy == CompactionSizeTiered || strategy == CompactionLeveled
+}
+
+//---------------------------------------------------------------------------//
+
+//Background Compactor: Runs Whenever a Flush Adds a Table, One Compaction at a Time, Until Nothing
+//Needs Merging
+func (l *LSM) compactLoop() {
+
+	defer l.compactor.Done()
+
+	for {
+
+		select {
+		case <-l.stop:
+			return
+		case <-l.compactSignal:
+		}
+
+		for {
+
+			done, err := l.compactOnce()
+			if err == errCompactionStopped {
+				return
+			}
+			if err != nil {
+				fmt.Println("Storage: Compaction Failed -", err)
+				break
+			}
+			if !done {
+				break
+			}
+
+		}
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Wake the Compactor; a Wake-Up Already Pending Covers this One
+func (l *LSM) requestCompaction() {
+
+	select {
+	case l.compactSignal <- struct{}{}:
+	default:
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Run One Compaction if the Strategy Finds One; False if there was Nothing to Do
+func (l *LSM) compactOnce() (bool, error) {
+
+	//Only the Compactor Removes Tables, so the Inputs Stay Open Once Chosen
+	l.mtx.RLock()
+	var picked *compaction
+	if l.options.Compaction == CompactionLeveled {
+		picked = l.pickLeveled()
+	} else {
+		picked = l.pickSizeTiered()
+	}
+	l.mtx.RUnlock()
+
+	if picked == nil {
+		return false, nil
+	}
+
+	sources := []iterator{}
+	inputSize := int64(0)
+	for _, table := range picked.inputs {
+		sources = append(sources, table.iterator(nil, nil))
+		inputSize += table.Size
+	}
+
+	//Leveled Output is Split into Tables of the Target Size, so a Level Never Holds Overlapping Tables
+	maxSize := int64(0)
+	if picked.level > 0 {
+		maxSize = l.options.TableSize
+	}
+
+	merged := &compactionIterator{source: newMergeIterator(sources), engine: l, inputs: picked.inputs}
+	merged.expiry = time.Now().Add(-l.options.TombstoneGrace).Unix()
+
+	outputs := []*sstable{}
+	for {
+
+		l.mtx.Lock()
+		generation := l.nextGeneration
+		l.nextGeneration++
+		l.mtx.Unlock()
+
+		table, err := writeTable(l.Dir, generation, picked.level, merged, l.options, maxSize, l.throttle)
+		if err != nil {
+			for _, output := range outputs {
+				output.release()
+			}
+			return false, err
+		}
+		if table == nil {
+			break
+		}
+
+		outputs = append(outputs, table)
+		if maxSize == 0 {
+			break
+		}
+
+	}
+
+	//Swap the Inputs for the Outputs in One Step, so a Read Sees One or the Other
+	l.mtx.Lock()
+
+	l.tables = slices.DeleteFunc(l.tables, func(table *sstable) bool { return slices.Contains(picked.inputs, table) })
+	for _, table := range outputs {
+		i := sort.Search(len(l.tables), func(i int) bool { return l.tables[i].Generation > table.Generation })
+		l.tables = slices.Insert(l.tables, i, table)
+	}
+
+	l.mtx.Unlock()
+
+	//A Scan Still Reading an Input Keeps its File Until it is Done
+	for _, table := range picked.inputs {
+		table.release()
+	}
+
+	outputSize := int64(0)
+	for _, table := range outputs {
+		outputSize += table.Size
+	}
+	fmt.Println("Storage: Compacted", len(picked.inputs), "SSTables (", inputSize, "Bytes ) into", len(outputs), "(", outputSize, "Bytes ) at Level", picked.level, "-", merged.dropped, "Tombstones Dropped")
+
+	return true, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Size-Tiered: Group the Tables into Tiers of Similar Size and Merge a Tier Once it Holds minThreshold
+//Tables, Smallest Tier First. Caller Holds the Lock
+func (l *LSM) pickSizeTiered() *compaction {
+
+	bySize := slices.Clone(l.tables)
+	sort.Slice(bySize, func(i, j int) bool { return bySize[i].Size < bySize[j].Size })
+
+	tiers := [][]*sstable{}
+	averages := []float64{}
+	for _, table := range bySize {
+
+		size := float64(table.Size)
+		last := len(tiers) - 1
+
+		if last >= 0 {
+			average := averages[last]
+			if (size >= average*tierLow && size <= average*tierHigh) || (table.Size < smallTierSize && average < smallTierSize) {
+				averages[last] = (average*float64(len(tiers[last])) + size) / float64(len(tiers[last])+1)
+				tiers[last] = append(tiers[last], table)
+				continue
+			}
+		}
+
+		tiers = append(tiers, []*sstable{table})
+		averages = append(averages, size)
+
+	}
+
+	for _, tier := range tiers {
+
+		if len(tier) < minThreshold {
+			continue
+		}
+
+		inputs := tier[:min(len(tier), maxThreshold)]
+
+		return &compaction{inputs: byGeneration(inputs), level: 0}
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Leveled: Level 0 Holds Flushed Tables, which May Overlap; Every Level Below Holds Tables with
+//Disjoint Key Ranges, and levelMultiplier Times the Bytes of the Level Above. Once Level 0 Holds
+//minThreshold Tables they are Merged into Level 1; Once a Level Outgrows its Limit, One of its Tables
+//(Taken in Turn Across the Key Range) is Merged into the Level Below. Caller Holds the Lock
+func (l *LSM) pickLeveled() *compaction {
+
+	levels := [][]*sstable{}
+	for _, table := range l.tables {
+		for len(levels) <= table.Level {
+			levels = append(levels, nil)
+		}
+		levels[table.Level] = append(levels[table.Level], table)
+	}
+
+	if len(levels) > 0 && len(levels[0]) >= minThreshold {
+
+		inputs := levels[0][:min(len(levels[0]), maxThreshold)]
+
+		first, last := inputs[0].FirstKey, inputs[0].LastKey
+		for _, table := range inputs {
+			first, last = min(first, table.FirstKey), max(last, table.LastKey)
+		}
+
+		if len(levels) > 1 {
+			inputs = append(slices.Clone(inputs), overlapping(levels[1], first, last)...)
+		}
+
+		return &compaction{inputs: byGeneration(inputs), level: 1}
+	}
+
+	limit := l.options.TableSize * levelTables
+	for level := 1; level < len(levels); level++ {
+
+		size := int64(0)
+		for _, table := range levels[level] {
+			size += table.Size
+		}
+
+		if size > limit {
+
+			tables := slices.Clone(levels[level])
+			sort.Slice(tables, func(i, j int) bool { return tables[i].FirstKey < tables[j].FirstKey })
+
+			//Next Table Past Where this Level Last Compacted, Wrapping Around
+			chosen := tables[0]
+			for _, table := range tables {
+				if table.FirstKey > l.compactPointer[level] {
+					chosen = table
+					break
+				}
+			}
+			l.compactPointer[level] = chosen.LastKey
+
+			inputs := []*sstable{chosen}
+			if level+1 < len(levels) {
+				inputs = append(inputs, overlapping(levels[level+1], chosen.FirstKey, chosen.LastKey)...)
+			}
+
+			return &compaction{inputs: byGeneration(inputs), level: level + 1}
+		}
+
+		limit *= levelMultiplier
+
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Tables Whose Keys May Fall in [first, last]
+func overlapping(tables []*sstable, first string, last string) []*sstable {
+
+	found := []*sstable{}
+	for _, table := range tables {
+		if table.overlaps(first, last) {
+			found = append(found, table)
+		}
+	}
+
+	return found
+}
+
+//---------------------------------------------------------------------------//
+
+//Copy Sorted Oldest First, the Order the Merge Reconciles Ties in
+func byGeneration(tables []*sstable) []*sstable {
+
+	sorted := slices.Clone(tables)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Generation < sorted[j].Generation })
+
+	return sorted
+}
+
+//---------------------------------------------------------------------------//
+
+//Merged Rows of a Compaction's Inputs - Overwritten Versions are Already Gone - Minus the Tombstones
+//Past their Grace Period that No Other Table or Memtable Could Hold an Older Version Under. Stops
+//Early if the Engine is Closing
+type compactionIterator struct {
+	source  iterator
+	engine  *LSM
+	inputs  []*sstable
+	expiry  int64 //Tombstones Written Before this Unix Time May be Dropped
+	dropped int
+	err     error
+}
+
+//---------------------------------------------------------------------------//
+
+func (it *compactionIterator) Next() bool {
+
+	for {
+
+		select {
+		case <-it.engine.stop:
+			it.err = errCompactionStopped
+			return false
+		default:
+		}
+
+		if !it.source.Next() {
+			return false
+		}
+
+		row := it.source.Row()
+		if !row.Tombstone || row.Timestamp >= it.expiry {
+			return true
+		}
+
+		shadowed, err := it.engine.heldElsewhere(it.source.Key(), it.inputs)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if shadowed {
+			return true
+		}
+
+		it.dropped++
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func (it *compactionIterator) Key() string { return it.source.Key() }
+func (it *compactionIterator) Row() Row    { return it.source.Row() }
+
+func (it *compactionIterator) Err() error {
+
+	if it.err != nil {
+		return it.err
+	}
+
+	return it.source.Err()
+}
+
+//---------------------------------------------------------------------------//
+
+//True if a Table Outside the Compaction, or a Memtable, Has a Version of the Key - Dropping the
+//Tombstone Would Bring that Version Back
+func (l *LSM) heldElsewhere(key string, inputs []*sstable) (bool, error) {
+
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	for _, table := range l.tables {
+
+		if slices.Contains(inputs, table) {
+			continue
+		}
+
+		_, found, err := table.get(key)
+		if err != nil || found {
+			return found, err
+		}
+
+	}
+
+	for _, frozen := range l.frozen {
+		if _, found := frozen.mem.get(key); found {
+			return true, nil
+		}
+	}
+
+	_, found := l.mem.get(key)
+
+	return found, nil
+}
+
+//---------------------------------------------------------------------------//
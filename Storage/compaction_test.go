@@ -0,0 +1,275 @@
+package Storage
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+//---------------------------------------------------------------------------//
+
+//Table Metadata for the Strategies, which Pick by Size, Level and Key Range Alone
+type tableSpec struct {
+	generation uint64
+	size       int64
+	level      int
+	first      string
+	last       string
+}
+
+//---------------------------------------------------------------------------//
+
+func specEngine(compaction string, specs []tableSpec) *LSM {
+
+	l := &LSM{compactPointer: map[int]string{}}
+	l.options = DefaultOptions()
+	l.options.Compaction = compaction
+	l.options.TableSize = 1 << 20 //Level 1 Holds 10 MB
+
+	for _, spec := range specs {
+		l.tables = append(l.tables, &sstable{Generation: spec.generation, Size: spec.size, Level: spec.level, FirstKey: spec.first, LastKey: spec.last})
+	}
+
+	return l
+}
+
+//Generations of the Picked Inputs and the Output Level, or "None"
+func describe(picked *compaction) string {
+
+	if picked == nil {
+		return "None"
+	}
+
+	generations := []uint64{}
+	for _, table := range picked.inputs {
+		generations = append(generations, table.Generation)
+	}
+
+	return fmt.Sprint(generations, " to Level ", picked.level)
+}
+
+//---------------------------------------------------------------------------//
+
+func TestPickSizeTiered(t *testing.T) {
+
+	const MB = 1 << 20
+
+	small := func(generations ...uint64) []tableSpec {
+		specs := []tableSpec{}
+		for _, generation := range generations {
+			specs = append(specs, tableSpec{generation: generation, size: int64(generation) * 1000})
+		}
+		return specs
+	}
+
+	many := []uint64{}
+	for generation := uint64(1); generation <= 40; generation++ {
+		many = append(many, generation)
+	}
+
+	tests := []struct {
+		name   string
+		tables []tableSpec
+		want   string
+	}{
+		{"Too Few Tables", small(1, 2, 3), "None"},
+		{"Small Tables Share a Tier", small(1, 2, 3, 4), "[1 2 3 4] to Level 0"},
+		{
+			name: "Tables of Similar Size",
+			tables: []tableSpec{
+				{generation: 1, size: 100 * MB}, {generation: 2, size: 400 * MB}, {generation: 3, size: 120 * MB},
+				{generation: 4, size: 90 * MB}, {generation: 5, size: 110 * MB},
+			},
+			want: "[1 3 4 5] to Level 0",
+		},
+		{
+			name: "Tiers Do Not Mix",
+			tables: []tableSpec{
+				{generation: 1, size: 20 * MB}, {generation: 2, size: 20 * MB},
+				{generation: 3, size: 100 * MB}, {generation: 4, size: 100 * MB},
+			},
+			want: "None",
+		},
+		{
+			name: "A Full Tier Behind a Short One",
+			tables: append(small(1, 2, 3), []tableSpec{
+				{generation: 4, size: 50 * MB}, {generation: 5, size: 50 * MB}, {generation: 6, size: 50 * MB}, {generation: 7, size: 50 * MB},
+			}...),
+			want: "[4 5 6 7] to Level 0",
+		},
+		{"At Most maxThreshold Smallest", small(many...), fmt.Sprint(many[:maxThreshold], " to Level 0")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := describe(specEngine(CompactionSizeTiered, test.tables).pickSizeTiered()); got != test.want {
+				t.Errorf("Picked %s, Want %s", got, test.want)
+			}
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestPickLeveled(t *testing.T) {
+
+	const MB = 1 << 20
+
+	tests := []struct {
+		name   string
+		tables []tableSpec
+		want   []string //Successive Picks
+	}{
+		{
+			name:   "Too Few Level 0 Tables",
+			tables: []tableSpec{{1, MB, 0, "a", "c"}, {2, MB, 0, "b", "d"}, {3, MB, 0, "c", "e"}},
+			want:   []string{"None"},
+		},
+		{
+			name: "Level 0 Merges with the Level 1 Tables it Overlaps",
+			tables: []tableSpec{
+				{1, MB, 1, "a", "b"}, {2, MB, 1, "f", "g"}, {3, MB, 1, "x", "z"},
+				{4, MB, 0, "a", "c"}, {5, MB, 0, "b", "d"}, {6, MB, 0, "c", "e"}, {7, MB, 0, "d", "e"},
+			},
+			want: []string{"[1 4 5 6 7] to Level 1"},
+		},
+		{
+			name:   "Level 1 Within its Limit",
+			tables: []tableSpec{{1, 4 * MB, 1, "a", "f"}, {2, 4 * MB, 1, "g", "m"}},
+			want:   []string{"None"},
+		},
+		{
+			name: "An Oversized Level Compacts its Tables in Turn",
+			tables: []tableSpec{
+				{1, 6 * MB, 1, "a", "f"}, {2, 6 * MB, 1, "g", "m"},
+				{3, MB, 2, "a", "c"}, {4, MB, 2, "h", "j"}, {5, MB, 2, "n", "p"},
+			},
+			want: []string{"[1 3] to Level 2", "[2 4] to Level 2", "[1 3] to Level 2"},
+		},
+		{
+			name:   "The Last Level Moves Down Alone",
+			tables: []tableSpec{{1, 6 * MB, 1, "a", "f"}, {2, 6 * MB, 1, "g", "m"}},
+			want:   []string{"[1] to Level 2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			l := specEngine(CompactionLeveled, test.tables)
+
+			//The Tables Stay Put Between Picks, so Only the Compaction Pointer Moves
+			for i, want := range test.want {
+				if got := describe(l.pickLeveled()); got != want {
+					t.Errorf("Pick %d: %s, Want %s", i+1, got, want)
+				}
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestTombstoneDropping(t *testing.T) {
+
+	now := time.Now().Unix()
+	expired := Row{Timestamp: 2, Tombstone: true}
+
+	tests := []struct {
+		name      string
+		otherRow  *Row //Older Version in a Table Outside the Compaction
+		memRow    *Row //Older Version Still in the Memtable
+		tombstone Row
+		want      []string
+		dropped   int
+	}{
+		{"Expired and Held Nowhere Else", nil, nil, expired, []string{"live=v@2"}, 1},
+		{"Expired but Another Table Holds the Key", &Row{Value: "old", Timestamp: 1}, nil, expired, []string{"key=-@2", "live=v@2"}, 0},
+		{"Expired but the Memtable Holds the Key", nil, &Row{Value: "old", Timestamp: 1}, expired, []string{"key=-@2", "live=v@2"}, 0},
+		{"Within its Grace Period", nil, nil, Row{Timestamp: now, Tombstone: true}, []string{fmt.Sprintf("key=-@%d", now), "live=v@2"}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			options := testOptions()
+			options.TombstoneGrace = time.Hour
+			l := openTestLSM(t, t.TempDir(), options)
+
+			if test.otherRow != nil {
+				mustPut(t, l, "key", *test.otherRow)
+				mustFlush(t, l)
+			}
+
+			mustPut(t, l, "key", test.tombstone)
+			mustPut(t, l, "live", Row{Value: "v", Timestamp: 2})
+			mustFlush(t, l)
+
+			if test.memRow != nil {
+				mustPut(t, l, "key", *test.memRow)
+			}
+
+			//Compact the Table Holding the Tombstone by Itself
+			inputs := l.tables[len(l.tables)-1:]
+			merged := &compactionIterator{source: newMergeIterator([]iterator{inputs[0].iterator(nil, nil)}), engine: l, inputs: inputs}
+			merged.expiry = time.Now().Add(-options.TombstoneGrace).Unix()
+
+			got := []string{}
+			for merged.Next() {
+				got = append(got, formatRow(merged.Key(), merged.Row()))
+			}
+
+			if merged.Err() != nil || fmt.Sprint(got) != fmt.Sprint(test.want) || merged.dropped != test.dropped {
+				t.Errorf("Kept %v, Dropped %d (%v) - Want %v, %d", got, merged.dropped, merged.Err(), test.want, test.dropped)
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Once Flushes Fill a Size Tier, the Background Compactor Merges it: the Newest Version of Each Key is
+//Kept and the Expired Tombstones are Dropped
+func TestBackgroundCompaction(t *testing.T) {
+
+	options := testOptions()
+	options.TombstoneGrace = time.Hour
+	l := openTestLSM(t, t.TempDir(), options)
+
+	for i := 1; i <= minThreshold; i++ {
+		mustPut(t, l, "shared", Row{Value: fmt.Sprint("v", i), Timestamp: int64(i)})
+		mustPut(t, l, fmt.Sprint("key", i), Row{Value: fmt.Sprint("v", i), Timestamp: int64(i)})
+		if i == minThreshold {
+			mustPut(t, l, "key1", Row{Timestamp: int64(i), Tombstone: true})
+		}
+		mustFlush(t, l)
+	}
+
+	//The Last Flush Woke the Compactor
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		l.mtx.RLock()
+		tables := len(l.tables)
+		l.mtx.RUnlock()
+		if tables == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d Tables Still Not Compacted", tables)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	want := []string{"key2=v2@2", "key3=v3@3", "key4=v4@4", "shared=v4@4"}
+	if got := scanAll(t, l, nil, nil); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("After Compaction: %v, Want %v", got, want)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
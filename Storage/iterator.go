@@ -0,0 +1,123 @@
+package Storage
+
+//---------------------------------------------------------------------------//
+
+//Walks Entries in Key Order: Call Next Before Each Entry; Once it Returns False, Err Tells Why
+type iterator interface {
+	Next() bool
+	Key() string
+	Row() Row
+	Err() error
+}
+
+//---------------------------------------------------------------------------//
+
+//Iterator over Entries Already in Memory (a Memtable Snapshot)
+type sliceIterator struct {
+	entries []entry
+	pos     int
+}
+
+func newSliceIterator(entries []entry) *sliceIterator {
+	return &sliceIterator{entries: entries, pos: -1}
+}
+
+func (it *sliceIterator) Next() bool {
+	it.pos++
+	return it.pos < len(it.entries)
+}
+
+func (it *sliceIterator) Key() string { return it.entries[it.pos].Key }
+func (it *sliceIterator) Row() Row    { return it.entries[it.pos].Row }
+func (it *sliceIterator) Err() error  { return nil }
+
+//---------------------------------------------------------------------------//
+
+//Merges Sources Ordered Oldest First into One Walk that Yields Each Key Once, with its Newest Row
+//(Row.Newer Breaks Equal Timestamps, as in the Memtable)
+type mergeIterator struct {
+	sources []iterator
+	valid   []bool
+	key     string
+	row     Row
+	err     error
+}
+
+//---------------------------------------------------------------------------//
+
+func newMergeIterator(sources []iterator) *mergeIterator {
+
+	it := new(mergeIterator)
+	it.sources = sources
+	it.valid = make([]bool, len(sources))
+
+	for i, source := range sources {
+		it.valid[i] = it.advance(source)
+	}
+
+	return it
+}
+
+//---------------------------------------------------------------------------//
+
+//Step a Source; a Failed Source Ends the Merge
+func (it *mergeIterator) advance(source iterator) bool {
+
+	if source.Next() {
+		return true
+	}
+
+	if err := source.Err(); err != nil && it.err == nil {
+		it.err = err
+	}
+
+	return false
+}
+
+//---------------------------------------------------------------------------//
+
+func (it *mergeIterator) Next() bool {
+
+	if it.err != nil {
+		return false
+	}
+
+	//Smallest Key Among the Sources
+	found := false
+	for i, source := range it.sources {
+		if it.valid[i] && (!found || source.Key() < it.key) {
+			it.key = source.Key()
+			found = true
+		}
+	}
+	if !found {
+		return false
+	}
+
+	//Reconcile Every Version of the Key, then Move Those Sources On
+	first := true
+	for i, source := range it.sources {
+
+		if !it.valid[i] || source.Key() != it.key {
+			continue
+		}
+
+		if first || source.Row().Newer(it.row) {
+			it.row = source.Row()
+			first = false
+		}
+
+		it.valid[i] = it.advance(source)
+
+	}
+
+	return it.err == nil
+}
+
+//---------------------------------------------------------------------------//
+
+func (it *mergeIterator) Key() string { return it.key }
+func (it *mergeIterator) Row() Row    { return it.row }
+func (it *mergeIterator) Err() error  { return it.err }
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,489 @@
+package Storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const DefaultMemtableSize = 4 << 20               //4 MB
+const DefaultBlockSize = 4 << 10                  //4 KB
+const DefaultTableSize = 4 << 20                  //4 MB
+const DefaultCompactionThroughput = 16 << 20      //16 MB per Second
+const DefaultTombstoneGrace = 10 * 24 * time.Hour //10 Days
+
+//LSM Engine Settings
+type Options struct {
+	MemtableSize         int           //Bytes a Memtable Holds Before it is Flushed to an SSTable
+	BlockSize            int           //Target Bytes per SSTable Data Block
+	Compaction           string        //CompactionSizeTiered or CompactionLeveled
+	TableSize            int64         //Leveled Compaction: Target Bytes per Output SSTable
+	CompactionThroughput int64         //Bytes per Second Compaction May Write; 0 for No Limit
+	FalsePositive        float64       //Bloom Filter False Positive Rate of New SSTables; 0 for No Filter
+	TombstoneGrace       time.Duration //How Long a Delete's Tombstone is Kept - Longer than a Down Replica Takes to be Repaired
+	Sync                 string        //Commit Log Sync Mode: SyncPerWrite, SyncGroup or SyncPeriodic
+	SyncPeriod           time.Duration //SyncPeriodic: Time Between Syncs
+	GroupWindow          time.Duration //SyncGroup: How Long a Sync Waits for More Writes to Join it
+}
+
+//A Full Memtable on its Way to an SSTable, with the Commit Log Segment Covering it
+type frozenMemtable struct {
+	mem *memtable
+	log *CommitLog
+}
+
+//Log-Structured Merge Engine. A Write Goes to the Commit Log, then the Memtable; a Full Memtable is
+//Frozen and Flushed to a New SSTable in the Background. A Read Merges the Memtables and Every SSTable
+//by Timestamp, so only Recent Writes Need to Fit in Memory
+type LSM struct {
+	Dir string
+
+	options        Options
+	mtx            sync.RWMutex
+	mem            *memtable
+	log            *CommitLog
+	frozen         []*frozenMemtable //Oldest First
+	tables         []*sstable        //Oldest First (by Generation)
+	nextGeneration uint64
+	flushes        sync.WaitGroup
+	closed         bool
+
+	throttle       *Throttle
+	compactSignal  chan struct{}
+	stop           chan struct{}
+	compactor      sync.WaitGroup
+	compactPointer map[int]string //Leveled: Last Key Each Level Compacted Up To
+}
+
+//---------------------------------------------------------------------------//
+
+func DefaultOptions() Options {
+	return Options{
+		MemtableSize:         DefaultMemtableSize,
+		BlockSize:            DefaultBlockSize,
+		Compaction:           CompactionSizeTiered,
+		TableSize:            DefaultTableSize,
+		CompactionThroughput: DefaultCompactionThroughput,
+		TombstoneGrace:       DefaultTombstoneGrace,
+		FalsePositive:        DefaultFalsePositive,
+		Sync:                 SyncPeriodic,
+		SyncPeriod:           DefaultSyncPeriod,
+		GroupWindow:          DefaultGroupWindow,
+	}
+}
+
+//---------------------------------------------------------------------------//
+
+//Open the Data Directory (Creating it if Needed): Load the SSTables, Replay Any Commit Log Left by
+//a Crash into a New SSTable, then Start a Fresh Memtable and Commit Log Segment and the Compactor
+func OpenLSM(dir string, options Options) (*LSM, error) {
+
+	if !validCompaction(options.Compaction) {
+		return nil, fmt.Errorf("unknown compaction strategy %q - expected %s or %s", options.Compaction, CompactionSizeTiered, CompactionLeveled)
+	}
+	if !validSync(options.Sync) {
+		return nil, fmt.Errorf("unknown commit log sync mode %q - expected %s, %s or %s", options.Sync, SyncPerWrite, SyncGroup, SyncPeriodic)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	l := new(LSM)
+	l.Dir = dir
+	l.options = options
+	l.nextGeneration = 1
+	l.throttle = NewThrottle(options.CompactionThroughput)
+	l.compactSignal = make(chan struct{}, 1)
+	l.stop = make(chan struct{})
+	l.compactPointer = map[int]string{}
+
+	//Half-Written Tables from an Interrupted Flush
+	leftovers, _ := filepath.Glob(filepath.Join(dir, "*.tmp"))
+	for _, fileName := range leftovers {
+		os.Remove(fileName)
+	}
+
+	tableFiles, err := generations(dir, "sstable-", tableSuffix)
+	if err != nil {
+		return nil, err
+	}
+	for _, generation := range tableFiles {
+
+		table, err := openTable(tableName(dir, generation), generation)
+		if err != nil {
+			l.closeTables()
+			return nil, err
+		}
+
+		l.tables = append(l.tables, table)
+		l.nextGeneration = max(l.nextGeneration, generation+1)
+
+	}
+
+	logFiles, err := generations(dir, "commitlog-", logSuffix)
+	if err != nil {
+		l.closeTables()
+		return nil, err
+	}
+
+	//Writes Not Flushed Before the Last Shutdown
+	recovered := newMemtable()
+	for _, generation := range logFiles {
+
+		replayed, truncated, err := RecoverCommitLog(logName(dir, generation), func(key string, row Row) error {
+			recovered.put(key, row)
+			return nil
+		})
+		if err != nil {
+			l.closeTables()
+			return nil, err
+		}
+		if replayed > 0 {
+			fmt.Println("Commit Log:", logName(dir, generation), replayed, "Writes Replayed")
+		}
+		if truncated > 0 {
+			fmt.Println("Commit Log:", logName(dir, generation), "Torn Tail of", truncated, "Bytes Dropped")
+		}
+		l.nextGeneration = max(l.nextGeneration, generation+1)
+
+	}
+
+	if recovered.count > 0 {
+
+		table, err := writeTable(dir, l.nextGeneration, 0, newSliceIterator(recovered.snapshot(nil, nil)), options, 0, nil)
+		if err != nil {
+			l.closeTables()
+			return nil, err
+		}
+
+		l.tables = append(l.tables, table)
+		l.nextGeneration++
+
+	}
+
+	//The Replayed Segments are in an SSTable Now
+	for _, generation := range logFiles {
+		if err := os.Remove(logName(dir, generation)); err != nil {
+			l.closeTables()
+			return nil, err
+		}
+	}
+
+	l.mem = newMemtable()
+	l.log, err = createLog(dir, l.nextGeneration, options)
+	if err != nil {
+		l.closeTables()
+		return nil, err
+	}
+	l.nextGeneration++
+
+	//Tables May Have Piled Up Before the Last Shutdown
+	l.compactor.Add(1)
+	go l.compactLoop()
+	l.requestCompaction()
+
+	return l, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Generations of the Files Named <prefix><Generation><suffix>, Ascending
+func generations(dir string, prefix string, suffix string) ([]uint64, error) {
+
+	fileNames, err := filepath.Glob(filepath.Join(dir, prefix+"*"+suffix))
+	if err != nil {
+		return nil, err
+	}
+
+	found := []uint64{}
+	for _, fileName := range fileNames {
+
+		number := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(fileName), prefix), suffix)
+		generation, err := strconv.ParseUint(number, 10, 64)
+		if err != nil {
+			continue
+		}
+		found = append(found, generation)
+
+	}
+
+	sort.Slice(found, func(i, j int) bool { return found[i] < found[j] })
+
+	return found, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Newest Version of the Key Across the SSTables and Memtables; the Zero Row if it Has None
+func (l *LSM) Get(key []byte) (Row, error) {
+
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	if l.closed {
+		return Row{}, ErrClosed
+	}
+
+	newest := Row{}
+	found := false
+
+	//Every Table and Memtable May Hold a Version - Row.Newer Picks One
+	for _, table := range l.tables {
+
+		row, inTable, err := table.get(string(key))
+		if err != nil {
+			return Row{}, err
+		}
+
+		if inTable && (!found || row.Newer(newest)) {
+			newest, found = row, true
+		}
+
+	}
+
+	memtables := []*memtable{}
+	for _, frozen := range l.frozen {
+		memtables = append(memtables, frozen.mem)
+	}
+	memtables = append(memtables, l.mem)
+
+	for _, mem := range memtables {
+		if row, inMemtable := mem.get(string(key)); inMemtable && (!found || row.Newer(newest)) {
+			newest, found = row, true
+		}
+	}
+
+	return newest, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Log the Write, then Apply it to the Memtable; Freezes the Memtable Once it is Full. Returns Once the
+//Sync Mode Counts the Write as Durable - Waiting for a Sync Without the Engine's Lock
+func (l *LSM) Put(key []byte, row Row) error {
+
+	l.mtx.Lock()
+
+	if l.closed {
+		l.mtx.Unlock()
+		return ErrClosed
+	}
+
+	log := l.log
+	sequence, err := log.write(string(key), row)
+	if err != nil {
+		l.mtx.Unlock()
+		return err
+	}
+
+	l.mem.put(string(key), row)
+
+	if l.mem.size >= l.options.MemtableSize {
+		err = l.freeze()
+	}
+
+	l.mtx.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	//Once the Segment is Frozen and Flushed, Closing it Syncs it
+	if err := log.commit(sequence); err != nil && err != ErrClosed {
+		return err
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+func (l *LSM) Delete(key []byte, timestamp int64) error {
+	return l.Put(key, Row{Timestamp: timestamp, Tombstone: true})
+}
+
+//---------------------------------------------------------------------------//
+
+//Visit the Rows (Tombstones Included) in [start, end) in Key Order Until visit Returns False. The
+//Memtables are Copied First, so visit May Call Back into the Engine; the SSTables are Held Open
+//Until the Scan Ends, Even if a Compaction Replaces them Meanwhile
+func (l *LSM) Scan(start []byte, end []byte, visit func(key []byte, row Row) bool) error {
+
+	l.mtx.RLock()
+
+	if l.closed {
+		l.mtx.RUnlock()
+		return ErrClosed
+	}
+
+	sources := []iterator{}
+	for _, table := range l.tables {
+		table.acquire()
+		defer table.release()
+		sources = append(sources, table.iterator(start, end))
+	}
+	for _, frozen := range l.frozen {
+		sources = append(sources, newSliceIterator(frozen.mem.snapshot(start, end)))
+	}
+	sources = append(sources, newSliceIterator(l.mem.snapshot(start, end)))
+
+	l.mtx.RUnlock()
+
+	merged := newMergeIterator(sources)
+	for merged.Next() {
+		if !visit([]byte(merged.Key()), merged.Row()) {
+			break
+		}
+	}
+
+	return merged.Err()
+}
+
+//---------------------------------------------------------------------------//
+
+//Flush the Memtable and Wait for Every Flush Under Way
+func (l *LSM) Flush() error {
+
+	l.mtx.Lock()
+	if l.closed {
+		l.mtx.Unlock()
+		return ErrClosed
+	}
+	err := l.freeze()
+	l.mtx.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	l.flushes.Wait()
+
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	if len(l.frozen) > 0 {
+		return fmt.Errorf("%d memtables could not be flushed; their writes stay in the commit log", len(l.frozen))
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Flush, Stop the Compactor (Abandoning a Compaction Under Way), then Release the Files. The Empty
+//Commit Log Segment is Left for the Next Open to Remove
+func (l *LSM) Close() error {
+
+	flushErr := l.Flush()
+	if flushErr == ErrClosed {
+		return nil
+	}
+
+	close(l.stop)
+	l.compactor.Wait()
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.closed = true
+
+	if err := l.log.Close(); err != nil && flushErr == nil {
+		flushErr = err
+	}
+	if err := l.closeTables(); err != nil && flushErr == nil {
+		flushErr = err
+	}
+
+	return flushErr
+}
+
+//---------------------------------------------------------------------------//
+
+//Start a New Memtable and Commit Log Segment, and Flush the Old Pair in the Background. Caller Holds the Lock
+func (l *LSM) freeze() error {
+
+	if l.mem.count == 0 {
+		return nil
+	}
+
+	log, err := createLog(l.Dir, l.nextGeneration, l.options)
+	if err != nil {
+		return err
+	}
+	l.nextGeneration++
+
+	frozen := &frozenMemtable{mem: l.mem, log: l.log}
+	l.frozen = append(l.frozen, frozen)
+	l.mem = newMemtable()
+	l.log = log
+
+	l.flushes.Add(1)
+	go l.flush(frozen)
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Write a Frozen Memtable to an SSTable, then Drop the Memtable and its Commit Log Segment. A Frozen
+//Memtable is Never Changed, so it is Read Here Without the Lock. If the Flush Fails, the Memtable
+//Keeps Serving Reads and the Segment is Replayed at the Next Open
+func (l *LSM) flush(frozen *frozenMemtable) {
+
+	defer l.flushes.Done()
+
+	table, err := writeTable(l.Dir, frozen.log.Generation, 0, newSliceIterator(frozen.mem.snapshot(nil, nil)), l.options, 0, nil)
+	if err != nil {
+		fmt.Println("Storage: Memtable Flush Failed -", err, "- its Writes Stay in", frozen.log.Path)
+		return
+	}
+
+	l.mtx.Lock()
+
+	i := sort.Search(len(l.tables), func(i int) bool { return l.tables[i].Generation > table.Generation })
+	l.tables = slices.Insert(l.tables, i, table)
+
+	for i, each := range l.frozen {
+		if each == frozen {
+			l.frozen = append(l.frozen[:i], l.frozen[i+1:]...)
+			break
+		}
+	}
+
+	l.mtx.Unlock()
+
+	l.requestCompaction()
+
+	frozen.log.Close()
+	if err := os.Remove(frozen.log.Path); err != nil {
+		fmt.Println("Storage:", err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Caller Holds the Lock (or Owns the Engine Alone)
+func (l *LSM) closeTables() error {
+
+	var firstErr error
+	for _, table := range l.tables {
+		if err := table.close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+//---------------------------------------------------------------------------//
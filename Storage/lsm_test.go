@@ -0,0 +1,368 @@
+package Storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+//---------------------------------------------------------------------------//
+
+//Settings Small Enough for a Test: Every Write Synced, Compaction Unthrottled
+func testOptions() Options {
+
+	options := DefaultOptions()
+	options.Sync = SyncPerWrite
+	options.CompactionThroughput = 0
+
+	return options
+}
+
+//---------------------------------------------------------------------------//
+
+func openTestLSM(t *testing.T, dir string, options Options) *LSM {
+
+	t.Helper()
+
+	l, err := OpenLSM(dir, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	return l
+}
+
+//---------------------------------------------------------------------------//
+
+func mustPut(t *testing.T, l *LSM, key string, row Row) {
+
+	t.Helper()
+
+	if err := l.Put([]byte(key), row); err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func mustFlush(t *testing.T, l *LSM) {
+
+	t.Helper()
+
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Every Row a Scan of [start, end) Visits, as "key=value@timestamp" (with "-" for a Tombstone)
+func scanAll(t *testing.T, l *LSM, start []byte, end []byte) []string {
+
+	t.Helper()
+
+	visited := []string{}
+	err := l.Scan(start, end, func(key []byte, row Row) bool {
+		visited = append(visited, formatRow(string(key), row))
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return visited
+}
+
+func formatRow(key string, row Row) string {
+
+	if row.Tombstone {
+		return fmt.Sprintf("%s=-@%d", key, row.Timestamp)
+	}
+
+	return fmt.Sprintf("%s=%s@%d", key, row.Value, row.Timestamp)
+}
+
+//---------------------------------------------------------------------------//
+
+func TestFlushThenRead(t *testing.T) {
+
+	dir := t.TempDir()
+	l := openTestLSM(t, dir, testOptions())
+
+	for i := 0; i < 100; i++ {
+		mustPut(t, l, fmt.Sprintf("key%03d", i), Row{Value: fmt.Sprintf("value%d", i), Timestamp: int64(i + 1)})
+	}
+	mustFlush(t, l)
+
+	if len(l.tables) != 1 || l.mem.count != 0 || len(l.frozen) != 0 {
+		t.Fatalf("After Flush: %d Tables, %d Memtable Rows, %d Frozen - Want 1, 0, 0", len(l.tables), l.mem.count, len(l.frozen))
+	}
+
+	//The Flushed Segment's Commit Log is Gone
+	if logs, _ := generations(dir, "commitlog-", logSuffix); len(logs) != 1 {
+		t.Errorf("Commit Log Segments %v, Want Only the Live One", logs)
+	}
+
+	for i := 0; i < 100; i++ {
+
+		row, err := l.Get([]byte(fmt.Sprintf("key%03d", i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := (Row{Value: fmt.Sprintf("value%d", i), Timestamp: int64(i + 1)}); row != want {
+			t.Errorf("key%03d = %+v, Want %+v", i, row, want)
+		}
+
+	}
+
+	if row, err := l.Get([]byte("absent")); err != nil || row != (Row{}) {
+		t.Errorf("Absent Key = %+v, %v - Want the Zero Row", row, err)
+	}
+
+	//The Table Outlives the Engine
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	reopened := openTestLSM(t, dir, testOptions())
+	if row, err := reopened.Get([]byte("key042")); err != nil || row.Value != "value42" {
+		t.Errorf("key042 After Reopen = %+v, %v", row, err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestMergeIteratorNewest(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		sources [][]entry //Oldest First
+		want    []string
+	}{
+		{
+			name:    "Newer Source Wins",
+			sources: [][]entry{{{"a", Row{Value: "old", Timestamp: 1}}}, {{"a", Row{Value: "new", Timestamp: 2}}}},
+			want:    []string{"a=new@2"},
+		},
+		{
+			name:    "Newer Timestamp Wins Over Newer Source",
+			sources: [][]entry{{{"a", Row{Value: "late", Timestamp: 9}}}, {{"a", Row{Value: "early", Timestamp: 2}}}},
+			want:    []string{"a=late@9"},
+		},
+		{
+			name:    "Equal Timestamps Keep the Greater Value",
+			sources: [][]entry{{{"a", Row{Value: "y", Timestamp: 5}}}, {{"a", Row{Value: "x", Timestamp: 5}}}, {{"a", Row{Value: "w", Timestamp: 5}}}},
+			want:    []string{"a=y@5"},
+		},
+		{
+			name:    "Equal Timestamps Keep the Tombstone",
+			sources: [][]entry{{{"a", Row{Timestamp: 5, Tombstone: true}}}, {{"a", Row{Value: "z", Timestamp: 5}}}},
+			want:    []string{"a=-@5"},
+		},
+		{
+			name: "Keys Interleave in Order",
+			sources: [][]entry{
+				{{"a", Row{Value: "1", Timestamp: 1}}, {"c", Row{Value: "1", Timestamp: 1}}},
+				{{"b", Row{Value: "2", Timestamp: 2}}, {"c", Row{Value: "2", Timestamp: 2}}},
+				{},
+				{{"d", Row{Value: "3", Timestamp: 3}}},
+			},
+			want: []string{"a=1@1", "b=2@2", "c=2@2", "d=3@3"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			sources := []iterator{}
+			for _, entries := range test.sources {
+				sources = append(sources, newSliceIterator(entries))
+			}
+
+			merged := newMergeIterator(sources)
+			got := []string{}
+			for merged.Next() {
+				got = append(got, formatRow(merged.Key(), merged.Row()))
+			}
+
+			if merged.Err() != nil || fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Errorf("Merged %v (%v), Want %v", got, merged.Err(), test.want)
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Versions Spread Over Two SSTables and the Memtable: Get and Scan Both Pick the Newest
+func TestNewestAcrossTablesAndMemtables(t *testing.T) {
+
+	l := openTestLSM(t, t.TempDir(), testOptions())
+
+	//First Table
+	mustPut(t, l, "a", Row{Value: "a1", Timestamp: 1})
+	mustPut(t, l, "b", Row{Value: "b3", Timestamp: 3})
+	mustPut(t, l, "c", Row{Value: "c1", Timestamp: 1})
+	mustPut(t, l, "d", Row{Value: "d-z", Timestamp: 4})
+	mustFlush(t, l)
+
+	//Second Table
+	mustPut(t, l, "a", Row{Value: "a2", Timestamp: 2})
+	mustPut(t, l, "b", Row{Value: "b2", Timestamp: 2})
+	mustPut(t, l, "c", Row{Timestamp: 2, Tombstone: true})
+	mustPut(t, l, "d", Row{Value: "d-m", Timestamp: 4})
+	mustFlush(t, l)
+
+	//Memtable
+	mustPut(t, l, "a", Row{Value: "a3", Timestamp: 3})
+	mustPut(t, l, "c", Row{Value: "c3", Timestamp: 3})
+	mustPut(t, l, "d", Row{Value: "d-a", Timestamp: 4})
+
+	if len(l.tables) != 2 {
+		t.Fatalf("%d Tables, Want 2", len(l.tables))
+	}
+
+	want := []string{"a=a3@3", "b=b3@3", "c=c3@3", "d=d-z@4"}
+
+	got := []string{}
+	for _, key := range []string{"a", "b", "c", "d"} {
+		row, err := l.Get([]byte(key))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, formatRow(key, row))
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Get: %v, Want %v", got, want)
+	}
+
+	if got := scanAll(t, l, nil, nil); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Scan: %v, Want %v", got, want)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestScanBounds(t *testing.T) {
+
+	l := openTestLSM(t, t.TempDir(), testOptions())
+
+	//b and d in a Table, the Rest in the Memtable, c Deleted
+	mustPut(t, l, "b", Row{Value: "b", Timestamp: 1})
+	mustPut(t, l, "d", Row{Value: "d", Timestamp: 1})
+	mustFlush(t, l)
+	mustPut(t, l, "a", Row{Value: "a", Timestamp: 1})
+	mustPut(t, l, "c", Row{Timestamp: 1, Tombstone: true})
+	mustPut(t, l, "e", Row{Value: "e", Timestamp: 1})
+
+	tests := []struct {
+		name       string
+		start, end []byte
+		want       []string
+	}{
+		{"Unbounded", nil, nil, []string{"a=a@1", "b=b@1", "c=-@1", "d=d@1", "e=e@1"}},
+		{"Start is Inclusive", []byte("b"), nil, []string{"b=b@1", "c=-@1", "d=d@1", "e=e@1"}},
+		{"End is Exclusive", nil, []byte("d"), []string{"a=a@1", "b=b@1", "c=-@1"}},
+		{"Both Bounds", []byte("b"), []byte("e"), []string{"b=b@1", "c=-@1", "d=d@1"}},
+		{"Bounds Between Keys", []byte("a0"), []byte("d0"), []string{"b=b@1", "c=-@1", "d=d@1"}},
+		{"Empty Range", []byte("c"), []byte("c"), []string{}},
+		{"Past the Last Key", []byte("f"), nil, []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := scanAll(t, l, test.start, test.end); fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Errorf("Scan [%q, %q): %v, Want %v", test.start, test.end, got, test.want)
+			}
+		})
+	}
+
+	//visit Ends the Scan Early
+	visited := 0
+	if err := l.Scan(nil, nil, func(key []byte, row Row) bool { visited++; return visited < 2 }); err != nil || visited != 2 {
+		t.Errorf("Stopped Scan Visited %d (%v), Want 2", visited, err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Stop the Engine the Way a Kill Would: No Flush, No Final Sync, Memtable Lost
+func crash(l *LSM) {
+
+	close(l.stop)
+	l.compactor.Wait()
+	l.flushes.Wait()
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.closed = true
+	l.log.file.Close()
+	l.closeTables()
+
+}
+
+//---------------------------------------------------------------------------//
+
+func TestReopenAfterCrash(t *testing.T) {
+
+	dir := t.TempDir()
+	l, err := OpenLSM(dir, testOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mustPut(t, l, "flushed", Row{Value: "old", Timestamp: 1})
+	mustFlush(t, l)
+	mustPut(t, l, "flushed", Row{Value: "new", Timestamp: 2})
+	mustPut(t, l, "logged", Row{Value: "v", Timestamp: 3})
+	mustPut(t, l, "deleted", Row{Value: "v", Timestamp: 3})
+	mustPut(t, l, "deleted", Row{Timestamp: 4, Tombstone: true})
+
+	segment := l.log.Path
+	crash(l)
+
+	//The Crash Came Mid-Append: Half a Record Follows the Whole Ones
+	torn := encodeRecord("torn", Row{Value: "lost", Timestamp: 5})
+	fileId, err := os.OpenFile(segment, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileId.Write(torn[:len(torn)/2])
+	fileId.Close()
+
+	reopened := openTestLSM(t, dir, testOptions())
+
+	//The Segment was Replayed into a Second SSTable and Removed; a Fresh, Empty One Took its Place
+	if len(reopened.tables) != 2 || reopened.mem.count != 0 {
+		t.Errorf("After Reopen: %d Tables, %d Memtable Rows - Want 2, 0", len(reopened.tables), reopened.mem.count)
+	}
+	if _, err := os.Stat(segment); !os.IsNotExist(err) {
+		t.Errorf("Replayed Segment %s Still There (%v)", filepath.Base(segment), err)
+	}
+	if logs, _ := generations(dir, "commitlog-", logSuffix); len(logs) != 1 || reopened.log.Size() != 0 {
+		t.Errorf("Commit Log Segments %v (Live One %d Bytes), Want One Empty Segment", logs, reopened.log.Size())
+	}
+
+	want := []string{"deleted=-@4", "flushed=new@2", "logged=v@3"}
+	if got := scanAll(t, reopened, nil, nil); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("After Reopen: %v, Want %v", got, want)
+	}
+
+	//Writes Go On After the Recovery
+	mustPut(t, reopened, "after", Row{Value: "v", Timestamp: 6})
+	if row, err := reopened.Get([]byte("after")); err != nil || row.Value != "v" {
+		t.Errorf("Write After Reopen = %+v, %v", row, err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,99 @@
+package Storage
+
+import (
+	"sort"
+	"sync"
+)
+
+//---------------------------------------------------------------------------//
+
+//Every Row Held in a Map - Nothing Survives a Restart Unless the Caller Logs its Writes Elsewhere
+type Memory struct {
+	mtx  sync.RWMutex
+	rows map[string]Row
+}
+
+//---------------------------------------------------------------------------//
+
+func NewMemory() *Memory {
+
+	memory := new(Memory)
+	memory.rows = make(map[string]Row)
+
+	return memory
+}
+
+//---------------------------------------------------------------------------//
+
+//The Stored Version of the Key; the Zero Row if it Has None
+func (m *Memory) Get(key []byte) (Row, error) {
+
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.rows[string(key)], nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Store the Row Unless the Stored Version is Newer
+func (m *Memory) Put(key []byte, row Row) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if current, found := m.rows[string(key)]; !found || row.Newer(current) {
+		m.rows[string(key)] = row
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+func (m *Memory) Delete(key []byte, timestamp int64) error {
+	return m.Put(key, Row{Timestamp: timestamp, Tombstone: true})
+}
+
+//---------------------------------------------------------------------------//
+
+//Visit the Rows (Tombstones Included) in [start, end) in Key Order Until visit Returns False. The
+//Rows are Copied First, so visit May Call Back into the Engine
+func (m *Memory) Scan(start []byte, end []byte, visit func(key []byte, row Row) bool) error {
+
+	m.mtx.RLock()
+	keys := []string{}
+	rows := make(map[string]Row)
+	for key, row := range m.rows {
+		if InRange(key, start, end) {
+			keys = append(keys, key)
+			rows[key] = row
+		}
+	}
+	m.mtx.RUnlock()
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !visit([]byte(key), rows[key]) {
+			break
+		}
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Nothing to Flush - the Map is the Only Copy
+func (m *Memory) Flush() error {
+	return nil
+}
+
+//---------------------------------------------------------------------------//
+
+func (m *Memory) Close() error {
+	return nil
+}
+
+//---------------------------------------------------------------------------//
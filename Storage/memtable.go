@@ -0,0 +1,128 @@
+package Storage
+
+import (
+	"math/rand"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const entryOverhead = 32 //Bytes Counted per Entry on Top of its Key and Value
+const maxLevel = 16      //Skip List Height - Plenty for Millions of Keys
+const levelChance = 4    //Each Level Holds About 1 in levelChance of the Nodes Below
+
+//A Key and its Newest Row
+type entry struct {
+	Key string
+	Row Row
+}
+
+//Skip List Node
+type node struct {
+	entry
+	next []*node
+}
+
+//Writes Not Yet in an SSTable, Kept Sorted by Key (a Skip List) so a Flush Writes them in Order.
+//Not Safe for Concurrent Use - the Engine Holds its Lock
+type memtable struct {
+	head   *node
+	height int
+	count  int
+	size   int //Approximate Bytes Held
+}
+
+//---------------------------------------------------------------------------//
+
+func newMemtable() *memtable {
+
+	m := new(memtable)
+	m.head = &node{next: make([]*node, maxLevel)}
+	m.height = 1
+
+	return m
+}
+
+//---------------------------------------------------------------------------//
+
+//The Last Node Before key on Each Level; update May be Nil
+func (m *memtable) seek(key string, update []*node) *node {
+
+	x := m.head
+	for level := m.height - 1; level >= 0; level-- {
+		for x.next[level] != nil && x.next[level].Key < key {
+			x = x.next[level]
+		}
+		if update != nil {
+			update[level] = x
+		}
+	}
+
+	//First Node Whose Key is Not Less than key
+	return x.next[0]
+}
+
+//---------------------------------------------------------------------------//
+
+func (m *memtable) get(key string) (Row, bool) {
+
+	x := m.seek(key, nil)
+	if x != nil && x.Key == key {
+		return x.Row, true
+	}
+
+	return Row{}, false
+}
+
+//---------------------------------------------------------------------------//
+
+//Keep the Row Unless the Memtable Already Holds a Newer Version
+func (m *memtable) put(key string, row Row) {
+
+	update := make([]*node, maxLevel)
+	x := m.seek(key, update)
+
+	if x != nil && x.Key == key {
+		if row.Newer(x.Row) {
+			m.size += len(row.Value) - len(x.Row.Value)
+			x.Row = row
+		}
+		return
+	}
+
+	height := 1
+	for height < maxLevel && rand.Intn(levelChance) == 0 {
+		height++
+	}
+	for level := m.height; level < height; level++ {
+		update[level] = m.head
+	}
+	if height > m.height {
+		m.height = height
+	}
+
+	x = &node{entry: entry{Key: key, Row: row}, next: make([]*node, height)}
+	for level := 0; level < height; level++ {
+		x.next[level] = update[level].next[level]
+		update[level].next[level] = x
+	}
+
+	m.count++
+	m.size += len(key) + len(row.Value) + entryOverhead
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Copy of the Entries in [start, end), in Key Order - Safe to Iterate Once the Lock is Released
+func (m *memtable) snapshot(start []byte, end []byte) []entry {
+
+	entries := []entry{}
+	for x := m.seek(string(start), nil); x != nil && InRange(x.Key, start, end); x = x.next[0] {
+		entries = append(entries, x.entry)
+	}
+
+	return entries
+}
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,502 @@
+package Storage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const tableSuffix = ".db"
+const tableMagic = 0x4c534d5353544232 //"LSMSSTB2"
+const footerSize = 32                 //Index Offset, Index Length, Level, Magic - 8 Bytes Each
+const blockTrailerSize = 4            //CRC32 of the Block
+
+//SSTable Errors
+var ErrCorruptTable = errors.New("sstable is corrupt")
+
+//Where One Data Block Sits in the File, and the First Key it Holds
+type blockHandle struct {
+	FirstKey string
+	Offset   int64
+	Length   int64 //Trailer Included
+}
+
+//Immutable Sorted File: Data Blocks of Entries, then the Block Index, then the Footer. Every Block
+//Ends with a CRC32 of its Contents. The Index - Sparse, One Key per Block - and the Bloom Filter are
+//Loaded when the Table is Opened, so a Point Read Reads One Data Block, and None for Most Keys the
+//Table Does Not Hold
+type sstable struct {
+	Generation uint64
+	Path       string
+	Size       int64
+	Level      int //0: Flushed from a Memtable (or Size-Tiered); 1 and Up: Leveled Compaction Output
+	FirstKey   string
+	LastKey    string
+
+	file   *os.File
+	index  []blockHandle
+	filter *bloomFilter //Nil for Tables Written Without One
+	refs   atomic.Int32 //The Engine's Reference, Plus One per Scan Reading the Table
+}
+
+//---------------------------------------------------------------------------//
+
+func tableName(dir string, generation uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("sstable-%06d%s", generation, tableSuffix))
+}
+
+//---------------------------------------------------------------------------//
+
+//Write the Entries (in Key Order) to a New SSTable; the File Appears Under its Final Name Only
+//Once it is Complete and Synced. With a maxSize, Writing Stops at the First Block Boundary Past it
+//and the Rest of source is Left for the Next Table. Returns a Nil Table if source Had No Entries
+func writeTable(dir string, generation uint64, level int, source iterator, options Options, maxSize int64, throttle *Throttle) (*sstable, error) {
+
+	path := tableName(dir, generation)
+	tmpPath := path + ".tmp"
+
+	fileId, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	fail := func(err error) (*sstable, error) {
+		fileId.Close()
+		os.Remove(tmpPath)
+		return nil, err
+	}
+
+	offset := int64(0)
+	index := []blockHandle{}
+	block := []byte{}
+	firstKey := ""
+	lastKey := ""
+	keyHashes := []uint64{}
+
+	writeBlock := func(contents []byte) (int64, error) {
+		contents = binary.BigEndian.AppendUint32(contents, crc32.ChecksumIEEE(contents))
+		if _, err := fileId.Write(contents); err != nil {
+			return 0, err
+		}
+		offset += int64(len(contents))
+		throttle.Wait(len(contents))
+		return int64(len(contents)), nil
+	}
+
+	for (maxSize == 0 || offset < maxSize) && source.Next() {
+
+		if len(block) == 0 {
+			firstKey = source.Key()
+		}
+		lastKey = source.Key()
+		keyHashes = append(keyHashes, bloomHash(source.Key()))
+		block = appendEntry(block, source.Key(), source.Row())
+
+		if len(block) >= options.BlockSize {
+			start := offset
+			length, err := writeBlock(block)
+			if err != nil {
+				return fail(err)
+			}
+			index = append(index, blockHandle{FirstKey: firstKey, Offset: start, Length: length})
+			block = block[:0]
+		}
+
+	}
+	if err := source.Err(); err != nil {
+		return fail(err)
+	}
+
+	if len(block) > 0 {
+		start := offset
+		length, err := writeBlock(block)
+		if err != nil {
+			return fail(err)
+		}
+		index = append(index, blockHandle{FirstKey: firstKey, Offset: start, Length: length})
+	}
+
+	if len(index) == 0 {
+		fileId.Close()
+		os.Remove(tmpPath)
+		return nil, nil
+	}
+
+	//Block Index: Uvarint Count, then per Block Uvarint Key Length, First Key, Uvarint Offset, Uvarint Length;
+	//then Uvarint Key Length, Last Key of the Table; then the Bloom Filter
+	indexBlock := binary.AppendUvarint(nil, uint64(len(index)))
+	for _, handle := range index {
+		indexBlock = binary.AppendUvarint(indexBlock, uint64(len(handle.FirstKey)))
+		indexBlock = append(indexBlock, handle.FirstKey...)
+		indexBlock = binary.AppendUvarint(indexBlock, uint64(handle.Offset))
+		indexBlock = binary.AppendUvarint(indexBlock, uint64(handle.Length))
+	}
+	indexBlock = binary.AppendUvarint(indexBlock, uint64(len(lastKey)))
+	indexBlock = append(indexBlock, lastKey...)
+	indexBlock = newBloomFilter(keyHashes, options.FalsePositive).appendTo(indexBlock)
+
+	indexOffset := offset
+	indexLength, err := writeBlock(indexBlock)
+	if err != nil {
+		return fail(err)
+	}
+
+	footer := make([]byte, footerSize)
+	binary.BigEndian.PutUint64(footer[0:8], uint64(indexOffset))
+	binary.BigEndian.PutUint64(footer[8:16], uint64(indexLength))
+	binary.BigEndian.PutUint64(footer[16:24], uint64(level))
+	binary.BigEndian.PutUint64(footer[24:32], tableMagic)
+	if _, err := fileId.Write(footer); err != nil {
+		return fail(err)
+	}
+
+	if err := fileId.Sync(); err != nil {
+		return fail(err)
+	}
+	if err := fileId.Close(); err != nil {
+		os.Remove(tmpPath)
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return nil, err
+	}
+	if err := syncDir(dir); err != nil {
+		return nil, err
+	}
+
+	return openTable(path, generation)
+}
+
+//---------------------------------------------------------------------------//
+
+//Open an SSTable and Load its Block Index
+func openTable(path string, generation uint64) (*sstable, error) {
+
+	fileId, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &sstable{Generation: generation, Path: path, file: fileId}
+	table.refs.Store(1)
+
+	if err := table.loadIndex(); err != nil {
+		fileId.Close()
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return table, nil
+}
+
+//---------------------------------------------------------------------------//
+
+func (t *sstable) loadIndex() error {
+
+	info, err := t.file.Stat()
+	if err != nil {
+		return err
+	}
+	t.Size = info.Size()
+
+	if t.Size < footerSize {
+		return ErrCorruptTable
+	}
+
+	footer := make([]byte, footerSize)
+	if _, err := t.file.ReadAt(footer, t.Size-footerSize); err != nil {
+		return err
+	}
+	if binary.BigEndian.Uint64(footer[24:32]) != tableMagic {
+		return ErrCorruptTable
+	}
+
+	indexOffset := int64(binary.BigEndian.Uint64(footer[0:8]))
+	indexLength := int64(binary.BigEndian.Uint64(footer[8:16]))
+	if indexOffset < 0 || indexLength < blockTrailerSize || indexOffset+indexLength > t.Size-footerSize {
+		return ErrCorruptTable
+	}
+	t.Level = int(binary.BigEndian.Uint64(footer[16:24]))
+
+	indexBlock, err := t.readBlock(indexOffset, indexLength)
+	if err != nil {
+		return err
+	}
+
+	count, n := binary.Uvarint(indexBlock)
+	if n <= 0 {
+		return ErrCorruptTable
+	}
+	pos := n
+
+	t.index = make([]blockHandle, 0, count)
+	for i := uint64(0); i < count; i++ {
+
+		keyLength, n := binary.Uvarint(indexBlock[pos:])
+		if n <= 0 || uint64(len(indexBlock)-pos-n) < keyLength {
+			return ErrCorruptTable
+		}
+		pos += n
+		handle := blockHandle{FirstKey: string(indexBlock[pos : pos+int(keyLength)])}
+		pos += int(keyLength)
+
+		offset, n := binary.Uvarint(indexBlock[pos:])
+		if n <= 0 {
+			return ErrCorruptTable
+		}
+		pos += n
+
+		length, n := binary.Uvarint(indexBlock[pos:])
+		if n <= 0 {
+			return ErrCorruptTable
+		}
+		pos += n
+
+		handle.Offset = int64(offset)
+		handle.Length = int64(length)
+		t.index = append(t.index, handle)
+
+	}
+
+	if len(t.index) == 0 {
+		return ErrCorruptTable
+	}
+	t.FirstKey = t.index[0].FirstKey
+
+	keyLength, n := binary.Uvarint(indexBlock[pos:])
+	if n <= 0 || uint64(len(indexBlock)-pos-n) < keyLength {
+		return ErrCorruptTable
+	}
+	pos += n
+	t.LastKey = string(indexBlock[pos : pos+int(keyLength)])
+	pos += int(keyLength)
+
+	t.filter, _, err = readBloomFilter(indexBlock[pos:])
+
+	return err
+}
+
+//---------------------------------------------------------------------------//
+
+//True if the Table's Keys May Fall in [start, end] (Both Included)
+func (t *sstable) overlaps(start string, end string) bool {
+	return t.FirstKey <= end && t.LastKey >= start
+}
+
+//---------------------------------------------------------------------------//
+
+//False Only if the Table Surely Does Not Hold the Key - Checked Before Any Block is Read
+func (t *sstable) mayContain(key string) bool {
+	return t.overlaps(key, key) && t.filter.mayContain(key)
+}
+
+//---------------------------------------------------------------------------//
+
+//Read a Block and Check its CRC; Returns the Contents Without the Trailer
+func (t *sstable) readBlock(offset int64, length int64) ([]byte, error) {
+
+	if length < blockTrailerSize {
+		return nil, ErrCorruptTable
+	}
+
+	buf := make([]byte, length)
+	if _, err := t.file.ReadAt(buf, offset); err != nil {
+		return nil, err
+	}
+
+	contents := buf[:length-blockTrailerSize]
+	if crc32.ChecksumIEEE(contents) != binary.BigEndian.Uint32(buf[length-blockTrailerSize:]) {
+		return nil, ErrCorruptTable
+	}
+
+	return contents, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Index of the Block that Would Hold key; -1 if key Sorts Before the Whole Table
+func (t *sstable) findBlock(key string) int {
+	return sort.Search(len(t.index), func(i int) bool { return t.index[i].FirstKey > key }) - 1
+}
+
+//---------------------------------------------------------------------------//
+
+//Point Read - Reads at Most One Data Block, and None if the Bloom Filter Rules the Key Out
+func (t *sstable) get(key string) (Row, bool, error) {
+
+	if !t.mayContain(key) {
+		return Row{}, false, nil
+	}
+
+	i := t.findBlock(key)
+	if i < 0 {
+		return Row{}, false, nil
+	}
+
+	block, err := t.readBlock(t.index[i].Offset, t.index[i].Length)
+	if err != nil {
+		return Row{}, false, fmt.Errorf("%s: %v", t.Path, err)
+	}
+
+	for pos := 0; pos < len(block); {
+
+		entryKey, row, n, err := readEntry(block[pos:])
+		if err != nil {
+			return Row{}, false, fmt.Errorf("%s: %v", t.Path, err)
+		}
+		pos += n
+
+		if entryKey == key {
+			return row, true, nil
+		}
+		if entryKey > key {
+			break
+		}
+
+	}
+
+	return Row{}, false, nil
+}
+
+//---------------------------------------------------------------------------//
+
+func (t *sstable) close() error {
+	return t.file.Close()
+}
+
+//---------------------------------------------------------------------------//
+
+//A Scan Holds the Table Open While it Reads
+func (t *sstable) acquire() {
+	t.refs.Add(1)
+}
+
+//---------------------------------------------------------------------------//
+
+//Drop a Reference; Once the Engine Has Dropped its Own (the Table was Compacted Away) and No Scan is
+//Reading, the File is Closed and Deleted
+func (t *sstable) release() {
+
+	if t.refs.Add(-1) > 0 {
+		return
+	}
+
+	t.close()
+	if err := os.Remove(t.Path); err != nil {
+		fmt.Println("Storage:", err)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Walks the Table's Entries in [start, end), One Block in Memory at a Time
+type tableIterator struct {
+	table *sstable
+	start []byte
+	end   []byte
+	block int //Index of the Block Being Read
+	data  []byte
+	pos   int
+	key   string
+	row   Row
+	err   error
+	done  bool
+}
+
+//---------------------------------------------------------------------------//
+
+func (t *sstable) iterator(start []byte, end []byte) *tableIterator {
+
+	it := &tableIterator{table: t, start: start, end: end}
+	it.block = max(t.findBlock(string(start)), 0) - 1
+
+	return it
+}
+
+//---------------------------------------------------------------------------//
+
+func (it *tableIterator) Next() bool {
+
+	for !it.done {
+
+		//Current Block Used Up - Load the Next
+		if it.pos >= len(it.data) {
+
+			it.block++
+			if it.block >= len(it.table.index) {
+				it.done = true
+				return false
+			}
+
+			handle := it.table.index[it.block]
+			if it.end != nil && handle.FirstKey >= string(it.end) {
+				it.done = true
+				return false
+			}
+
+			it.data, it.err = it.table.readBlock(handle.Offset, handle.Length)
+			if it.err != nil {
+				it.err = fmt.Errorf("%s: %v", it.table.Path, it.err)
+				it.done = true
+				return false
+			}
+			it.pos = 0
+			continue
+
+		}
+
+		key, row, n, err := readEntry(it.data[it.pos:])
+		if err != nil {
+			it.err = fmt.Errorf("%s: %v", it.table.Path, err)
+			it.done = true
+			return false
+		}
+		it.pos += n
+
+		if key < string(it.start) {
+			continue
+		}
+		if it.end != nil && key >= string(it.end) {
+			it.done = true
+			return false
+		}
+
+		it.key = key
+		it.row = row
+		return true
+
+	}
+
+	return false
+}
+
+//---------------------------------------------------------------------------//
+
+func (it *tableIterator) Key() string { return it.key }
+func (it *tableIterator) Row() Row    { return it.row }
+func (it *tableIterator) Err() error  { return it.err }
+
+//---------------------------------------------------------------------------//
+
+//Make Renames and Removals in the Directory Durable
+func syncDir(dir string) error {
+
+	dirId, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer dirId.Close()
+
+	return dirId.Sync()
+}
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,107 @@
+package Storage
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+//---------------------------------------------------------------------------//
+
+//Storage Errors
+var ErrCorruptEntry = errors.New("storage entry is malformed")
+var ErrClosed = errors.New("storage engine is closed")
+var ErrEntryTooLarge = errors.New("storage entry exceeds the maximum commit log record size")
+
+//Entry Flags
+const flagTombstone = 1
+
+//One Version of a Row. Versions of a Key are Reconciled by Timestamp - the Newest Wins,
+//and a Tombstone (a Delete) Wins the Same Way a Value Does
+type Row struct {
+	Value     string
+	Timestamp int64
+	Tombstone bool
+}
+
+//---------------------------------------------------------------------------//
+
+//True if a Supersedes b. On Equal Timestamps a Tombstone Wins, then the Greater Value, so Every
+//Replica Settles on the Same Version Whatever Order the Writes Arrive in
+func (a Row) Newer(b Row) bool {
+
+	if a.Timestamp != b.Timestamp {
+		return a.Timestamp > b.Timestamp
+	}
+	if a.Tombstone != b.Tombstone {
+		return a.Tombstone
+	}
+
+	return a.Value > b.Value
+}
+
+//---------------------------------------------------------------------------//
+
+//True if the Key Falls in [start, end); a Nil end Means No Upper Bound
+func InRange(key string, start []byte, end []byte) bool {
+	return key >= string(start) && (end == nil || key < string(end))
+}
+
+//---------------------------------------------------------------------------//
+
+//Entry - the Encoding of a Key and its Row Shared by the Commit Log and SSTable Blocks:
+//Uvarint Key Length, Key, Varint Timestamp, Flags Byte, Uvarint Value Length, Value
+func appendEntry(buf []byte, key string, row Row) []byte {
+
+	buf = binary.AppendUvarint(buf, uint64(len(key)))
+	buf = append(buf, key...)
+	buf = binary.AppendVarint(buf, row.Timestamp)
+
+	flags := byte(0)
+	if row.Tombstone {
+		flags |= flagTombstone
+	}
+	buf = append(buf, flags)
+
+	buf = binary.AppendUvarint(buf, uint64(len(row.Value)))
+	buf = append(buf, row.Value...)
+
+	return buf
+}
+
+//---------------------------------------------------------------------------//
+
+//Decode the Entry at the Start of buf; Returns the Bytes it Used
+func readEntry(buf []byte) (string, Row, int, error) {
+
+	pos := 0
+
+	keyLength, n := binary.Uvarint(buf[pos:])
+	if n <= 0 || uint64(len(buf)-pos-n) < keyLength {
+		return "", Row{}, 0, ErrCorruptEntry
+	}
+	pos += n
+	key := string(buf[pos : pos+int(keyLength)])
+	pos += int(keyLength)
+
+	row := Row{}
+	row.Timestamp, n = binary.Varint(buf[pos:])
+	if n <= 0 || pos+n >= len(buf) {
+		return "", Row{}, 0, ErrCorruptEntry
+	}
+	pos += n
+
+	row.Tombstone = buf[pos]&flagTombstone != 0
+	pos++
+
+	valueLength, n := binary.Uvarint(buf[pos:])
+	if n <= 0 || uint64(len(buf)-pos-n) < valueLength {
+		return "", Row{}, 0, ErrCorruptEntry
+	}
+	pos += n
+	row.Value = string(buf[pos : pos+int(valueLength)])
+	pos += int(valueLength)
+
+	return key, row, pos, nil
+}
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,68 @@
+package Storage
+
+import (
+	"sync"
+	"time"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const throttleIdle = time.Second //A Pause this Long Starts a New Measurement Window
+
+//Caps the Bytes per Second Written by Background Work (Compaction), so it Leaves the Disk to
+//Requests. A Nil Throttle Never Waits
+type Throttle struct {
+	BytesPerSecond int64
+
+	mtx   sync.Mutex
+	start time.Time
+	last  time.Time
+	bytes int64
+}
+
+//---------------------------------------------------------------------------//
+
+//Nil (No Limit) Unless bytesPerSecond is Positive
+func NewThrottle(bytesPerSecond int64) *Throttle {
+
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+
+	return &Throttle{BytesPerSecond: bytesPerSecond}
+}
+
+//---------------------------------------------------------------------------//
+
+//Account for n Bytes, Sleeping as Long as it Takes to Stay Under the Limit
+func (t *Throttle) Wait(n int) {
+
+	if t == nil {
+		return
+	}
+
+	t.mtx.Lock()
+
+	now := time.Now()
+	if now.Sub(t.last) > throttleIdle {
+		t.start = now
+		t.bytes = 0
+	}
+	t.bytes += int64(n)
+
+	due := t.start.Add(time.Duration(float64(t.bytes) / float64(t.BytesPerSecond) * float64(time.Second)))
+	t.last = now
+	if due.After(now) {
+		t.last = due
+	}
+
+	t.mtx.Unlock()
+
+	if delay := due.Sub(now); delay > 0 {
+		time.Sleep(delay)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,67 @@
+package Storage
+
+import (
+	"testing"
+	"time"
+)
+
+//---------------------------------------------------------------------------//
+
+func TestThrottle(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		bytesPerSecond int64
+		chunks         int
+		chunkSize      int
+		min, max       time.Duration
+	}{
+		{"No Limit", 0, 100, 1 << 20, 0, 50 * time.Millisecond},
+		{"Limited", 100 << 10, 5, 10 << 10, 400 * time.Millisecond, 900 * time.Millisecond},
+		{"Under the Limit", 100 << 20, 5, 10 << 10, 0, 50 * time.Millisecond},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			throttle := NewThrottle(test.bytesPerSecond)
+			if (throttle == nil) != (test.bytesPerSecond <= 0) {
+				t.Fatalf("NewThrottle(%d) = %v", test.bytesPerSecond, throttle)
+			}
+
+			start := time.Now()
+			for i := 0; i < test.chunks; i++ {
+				throttle.Wait(test.chunkSize)
+			}
+
+			if elapsed := time.Since(start); elapsed < test.min || elapsed > test.max {
+				t.Errorf("%d Chunks of %d Bytes Took %v, Want %v to %v", test.chunks, test.chunkSize, elapsed, test.min, test.max)
+			}
+
+		})
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//A Pause Longer than throttleIdle is Not Credited - the Bytes After it are Paced from the Pause's End
+func TestThrottleIdle(t *testing.T) {
+
+	throttle := NewThrottle(100 << 10)
+
+	throttle.Wait(10 << 10)
+	time.Sleep(throttleIdle + 100*time.Millisecond)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		throttle.Wait(10 << 10)
+	}
+
+	if elapsed := time.Since(start); elapsed < 250*time.Millisecond {
+		t.Errorf("Writes After an Idle Pause Took %v, Want About 300ms", elapsed)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
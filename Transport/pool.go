@@ -0,0 +1,280 @@
+package Transport
+
+import (
+	"../Protobuf"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const DefaultDialTimeout = 5 * time.Second
+
+//Longest a Dial to a Peer May Take
+var DialTimeout = DefaultDialTimeout
+
+//Connection Errors
+var ErrConnectionClosed = errors.New("connection closed")
+var ErrRequestTimeout = errors.New("request timed out")
+
+//Request IDs are Unique per Process; 0 is Left for Un-Tagged Requests
+var lastRequestId uint64
+
+//Long-Lived Connection Shared by Many In-Flight Requests
+type Connection struct {
+	conn     *net.TCPConn
+	writeMtx sync.Mutex
+
+	mtx     sync.Mutex
+	pending map[uint64]chan *cassandra.InputRequest
+	closed  bool
+}
+
+//Pool of Connections to One Peer
+type Pool struct {
+	Address *net.TCPAddr
+	Size    int
+
+	mtx   sync.Mutex
+	conns []*Connection
+	next  int
+}
+
+//---------------------------------------------------------------------------//
+
+func NextRequestId() uint64 {
+	return atomic.AddUint64(&lastRequestId, 1)
+}
+
+//---------------------------------------------------------------------------//
+
+func NewPool(address *net.TCPAddr, size int) *Pool {
+
+	if size < 1 {
+		size = 1
+	}
+
+	pool := new(Pool)
+	pool.Address = address
+	pool.Size = size
+	pool.conns = make([]*Connection, size)
+
+	return pool
+}
+
+//---------------------------------------------------------------------------//
+
+//Pick the Next Connection (Round-Robin), Re-Dialing it if Broken. The Dial Runs Without the Lock,
+//so an Unreachable Peer Doesn't Hold Up Callers that Can Reuse a Live Connection
+func (p *Pool) Get() (*Connection, error) {
+
+	p.mtx.Lock()
+
+	slot := p.next
+	p.next = (p.next + 1) % p.Size
+
+	if c := p.conns[slot]; c != nil && !c.isClosed() {
+		p.mtx.Unlock()
+		return c, nil
+	}
+
+	p.mtx.Unlock()
+
+	conn, err := net.DialTimeout("tcp", p.Address.String(), DialTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	//Another Caller Re-Dialed the Slot Meanwhile
+	if c := p.conns[slot]; c != nil && !c.isClosed() {
+		conn.Close()
+		return c, nil
+	}
+
+	c := new(Connection)
+	c.conn = conn.(*net.TCPConn)
+	c.pending = make(map[uint64]chan *cassandra.InputRequest)
+	p.conns[slot] = c
+
+	go c.readLoop()
+
+	return c, nil
+}
+
+//---------------------------------------------------------------------------//
+
+//Send a Request Without Waiting for a Response
+func (p *Pool) Send(msg *cassandra.InputRequest) error {
+
+	c, err := p.Get()
+	if err != nil {
+		return err
+	}
+
+	msg.RequestId = NextRequestId()
+
+	return c.write(msg)
+}
+
+//---------------------------------------------------------------------------//
+
+//Send a Request and Wait for the Response Carrying the Same Request ID
+func (p *Pool) Call(msg *cassandra.InputRequest, timeout time.Duration) (*cassandra.InputRequest, error) {
+
+	c, err := p.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	msg.RequestId = NextRequestId()
+	respChan := make(chan *cassandra.InputRequest, 1)
+
+	if !c.register(msg.RequestId, respChan) {
+		return nil, ErrConnectionClosed
+	}
+	defer c.unregister(msg.RequestId)
+
+	if err := c.write(msg); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case resp, ok := <-respChan:
+		if !ok {
+			return nil, ErrConnectionClosed
+		}
+		return resp, nil
+	case <-timer.C:
+		return nil, ErrRequestTimeout
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Close Every Connection in the Pool
+func (p *Pool) Close() {
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for i, c := range p.conns {
+		if c != nil {
+			c.close()
+			p.conns[i] = nil
+		}
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func (c *Connection) write(msg *cassandra.InputRequest) error {
+
+	c.writeMtx.Lock()
+	err := WriteMessage(c.conn, msg)
+	c.writeMtx.Unlock()
+
+	if err != nil {
+		c.close()
+	}
+
+	return err
+}
+
+//---------------------------------------------------------------------------//
+
+//Route Each Response to the Caller Waiting on its Request ID
+func (c *Connection) readLoop() {
+
+	for {
+
+		respMsg := new(cassandra.InputRequest)
+		if err := ReadMessage(c.conn, respMsg); err != nil {
+			c.close()
+			return
+		}
+
+		c.mtx.Lock()
+		respChan, found := c.pending[respMsg.GetRequestId()]
+		delete(c.pending, respMsg.GetRequestId())
+		c.mtx.Unlock()
+
+		//Nobody is Waiting (Timed Out or One-Way Request)
+		if found {
+			respChan <- respMsg
+		}
+
+	}
+
+}
+
+//---------------------------------------------------------------------------//
+
+func (c *Connection) register(requestId uint64, respChan chan *cassandra.InputRequest) bool {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.closed {
+		return false
+	}
+
+	c.pending[requestId] = respChan
+
+	return true
+}
+
+//---------------------------------------------------------------------------//
+
+func (c *Connection) unregister(requestId uint64) {
+
+	c.mtx.Lock()
+	delete(c.pending, requestId)
+	c.mtx.Unlock()
+
+}
+
+//---------------------------------------------------------------------------//
+
+func (c *Connection) isClosed() bool {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.closed
+}
+
+//---------------------------------------------------------------------------//
+
+//Close the Socket and Fail Every Caller Still Waiting
+func (c *Connection) close() {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.closed {
+		return
+	}
+
+	c.closed = true
+	c.conn.Close()
+
+	for requestId, respChan := range c.pending {
+		close(respChan)
+		delete(c.pending, requestId)
+	}
+
+}
+
+//---------------------------------------------------------------------------//
@@ -0,0 +1,69 @@
+package Transport
+
+import (
+	"../Protobuf"
+	"net"
+	"sync"
+)
+
+//---------------------------------------------------------------------------//
+
+//Server Side of a Connection; Responses to Concurrent Requests Share the Socket
+type Session struct {
+	conn     *net.TCPConn
+	writeMtx sync.Mutex
+}
+
+//Where the Response to One Request Goes
+type Reply struct {
+	session   *Session
+	RequestId uint64
+}
+
+//---------------------------------------------------------------------------//
+
+func NewSession(conn *net.TCPConn) *Session {
+
+	session := new(Session)
+	session.conn = conn
+
+	return session
+}
+
+//---------------------------------------------------------------------------//
+
+func (s *Session) Read(msg *cassandra.InputRequest) error {
+	return ReadMessage(s.conn, msg)
+}
+
+//---------------------------------------------------------------------------//
+
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
+
+//---------------------------------------------------------------------------//
+
+func (s *Session) ReplyTo(requestId uint64) *Reply {
+
+	reply := new(Reply)
+	reply.session = s
+	reply.RequestId = requestId
+
+	return reply
+}
+
+//---------------------------------------------------------------------------//
+
+//Send the Response Tagged with the Request ID it Answers
+func (r *Reply) Send(msg *cassandra.InputRequest) error {
+
+	msg.RequestId = r.RequestId
+
+	r.session.writeMtx.Lock()
+	defer r.session.writeMtx.Unlock()
+
+	return WriteMessage(r.session.conn, msg)
+}
+
+//---------------------------------------------------------------------------//
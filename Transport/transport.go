@@ -0,0 +1,89 @@
+package Transport
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"io"
+)
+
+//---------------------------------------------------------------------------//
+
+//Constants Declaration
+const headerSize = 4                   //4-Byte Big-Endian Length Prefix
+const DefaultMaxMessageSize = 64 << 20 //64 MB
+
+//Largest Frame Accepted/Sent by this Process
+var MaxMessageSize uint32 = DefaultMaxMessageSize
+
+//Frame Error
+var ErrFrameTooLarge = errors.New("frame exceeds the maximum message size")
+
+//---------------------------------------------------------------------------//
+
+//Set the Maximum Message Size (in bytes) for Both Directions
+func SetMaxMessageSize(size uint32) {
+
+	if size == 0 {
+		size = DefaultMaxMessageSize
+	}
+
+	MaxMessageSize = size
+
+}
+
+//---------------------------------------------------------------------------//
+
+//Marshal the Message and Write it as One Length-Prefixed Frame
+func WriteMessage(conn io.Writer, msg proto.Message) error {
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if uint32(len(data)) > MaxMessageSize {
+		return fmt.Errorf("%w: %d bytes (max %d)", ErrFrameTooLarge, len(data), MaxMessageSize)
+	}
+
+	//Header and Payload go out in a Single Write
+	frame := make([]byte, headerSize+len(data))
+	binary.BigEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[headerSize:], data)
+
+	_, err = conn.Write(frame)
+
+	return err
+}
+
+//---------------------------------------------------------------------------//
+
+//Read One Complete Length-Prefixed Frame and Un-Marshal it into msg
+func ReadMessage(conn io.Reader, msg proto.Message) error {
+
+	//Read the Frame Header
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return err
+	}
+
+	frameSize := binary.BigEndian.Uint32(header)
+
+	if frameSize > MaxMessageSize {
+		return fmt.Errorf("%w: %d bytes (max %d)", ErrFrameTooLarge, frameSize, MaxMessageSize)
+	}
+
+	//Read the Whole Payload, However Many TCP Reads it Takes
+	payload := make([]byte, frameSize)
+	if _, err := io.ReadFull(conn, payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	return proto.Unmarshal(payload, msg)
+}
+
+//---------------------------------------------------------------------------//